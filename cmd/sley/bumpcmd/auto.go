@@ -11,7 +11,6 @@ import (
 	"github.com/indaco/sley/internal/operations"
 	"github.com/indaco/sley/internal/plugins/changelogparser"
 	"github.com/indaco/sley/internal/plugins/commitparser"
-	"github.com/indaco/sley/internal/plugins/commitparser/gitlog"
 	"github.com/indaco/sley/internal/printer"
 	"github.com/indaco/sley/internal/semver"
 	"github.com/urfave/cli/v3"
@@ -20,6 +19,10 @@ import (
 var (
 	tryInferBumpTypeFromCommitParserPluginFn    = tryInferBumpTypeFromCommitParserPlugin
 	tryInferBumpTypeFromChangelogParserPluginFn = tryInferBumpTypeFromChangelogParserPlugin
+
+	// newGitCommitLogReaderFn constructs the git commit log reader used to
+	// infer a bump type from commit history. Overridable for tests.
+	newGitCommitLogReaderFn = semver.NewGitCommitLogReader
 )
 
 // autoCmd returns the "auto" subcommand.
@@ -97,17 +100,17 @@ func runBumpAuto(ctx context.Context, cfg *config.Config, cmd *cli.Command) erro
 
 	// Handle single-module mode
 	if execCtx.IsSingleModule() {
-		return runSingleModuleAuto(cmd, execCtx.Path, label, meta, since, until, isPreserveMeta, disableInfer)
+		return runSingleModuleAuto(ctx, cmd, execCtx.Path, label, meta, since, until, isPreserveMeta, disableInfer)
 	}
 
 	// Handle multi-module mode
 	// For auto bump, we need to determine the bump type first
-	bumpType := determineBumpType(label, disableInfer, since, until)
-	return runMultiModuleBump(ctx, cmd, execCtx, bumpType, "", meta, isPreserveMeta)
+	bumpType := determineBumpType(ctx, label, disableInfer, since, until)
+	return runMultiModuleBump(ctx, cmd, cfg, execCtx, bumpType, "", meta, isPreserveMeta)
 }
 
 // determineBumpType determines the bump type for multi-module auto bump.
-func determineBumpType(label string, disableInfer bool, since, until string) operations.BumpType {
+func determineBumpType(ctx context.Context, label string, disableInfer bool, since, until string) operations.BumpType {
 	switch label {
 	case "patch":
 		return operations.BumpPatch
@@ -121,7 +124,7 @@ func determineBumpType(label string, disableInfer bool, since, until string) ope
 			inferred := tryInferBumpTypeFromChangelogParserPluginFn()
 			if inferred == "" {
 				// Fall back to commit parser
-				inferred = tryInferBumpTypeFromCommitParserPluginFn(since, until)
+				inferred = tryInferBumpTypeFromCommitParserPluginFn(ctx, since, until)
 			}
 
 			if inferred != "" {
@@ -145,7 +148,7 @@ func determineBumpType(label string, disableInfer bool, since, until string) ope
 }
 
 // runSingleModuleAuto handles the single-module auto bump operation.
-func runSingleModuleAuto(cmd *cli.Command, path, label, meta, since, until string, isPreserveMeta, disableInfer bool) error {
+func runSingleModuleAuto(ctx context.Context, cmd *cli.Command, path, label, meta, since, until string, isPreserveMeta, disableInfer bool) error {
 	if _, err := clix.FromCommandFn(cmd); err != nil {
 		return err
 	}
@@ -155,7 +158,7 @@ func runSingleModuleAuto(cmd *cli.Command, path, label, meta, since, until strin
 		return fmt.Errorf("failed to read version: %w", err)
 	}
 
-	next, err := getNextVersion(current, label, disableInfer, since, until, isPreserveMeta)
+	next, err := getNextVersion(ctx, current, label, disableInfer, since, until, isPreserveMeta)
 	if err != nil {
 		return err
 	}
@@ -204,6 +207,7 @@ func runSingleModuleAuto(cmd *cli.Command, path, label, meta, since, until strin
 // commit inference, or default bump logic. It returns an error if bumping fails
 // or if an invalid label is specified.
 func getNextVersion(
+	ctx context.Context,
 	current semver.SemVersion,
 	label string,
 	disableInfer bool,
@@ -225,7 +229,7 @@ func getNextVersion(
 			inferred := tryInferBumpTypeFromChangelogParserPluginFn()
 			if inferred == "" {
 				// Fall back to commit parser
-				inferred = tryInferBumpTypeFromCommitParserPluginFn(since, until)
+				inferred = tryInferBumpTypeFromCommitParserPluginFn(ctx, since, until)
 			}
 
 			if inferred != "" {
@@ -279,14 +283,16 @@ func promotePreRelease(current semver.SemVersion, preserveMeta bool) semver.SemV
 	return next
 }
 
-// tryInferBumpTypeFromCommitParserPlugin tries to infer bump type from commit messages.
-func tryInferBumpTypeFromCommitParserPlugin(since, until string) string {
+// tryInferBumpTypeFromCommitParserPlugin tries to infer bump type from commit
+// messages, read the same way as the multi-module auto-bump path
+// (semver.GitCommitLogReader), so both paths agree on the no-tag fallback.
+func tryInferBumpTypeFromCommitParserPlugin(ctx context.Context, since, until string) string {
 	parser := commitparser.GetCommitParserFn()
 	if parser == nil {
 		return ""
 	}
 
-	commits, err := gitlog.GetCommitsFn(since, until)
+	commits, err := newGitCommitLogReaderFn().CommitsSinceLastTag(ctx, since, until)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to read commits: %v\n", err)
 		return ""
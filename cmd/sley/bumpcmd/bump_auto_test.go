@@ -12,7 +12,6 @@ import (
 	"github.com/indaco/sley/internal/config"
 	"github.com/indaco/sley/internal/plugins"
 	"github.com/indaco/sley/internal/plugins/commitparser"
-	"github.com/indaco/sley/internal/plugins/commitparser/gitlog"
 	"github.com/indaco/sley/internal/plugins/tagmanager"
 	"github.com/indaco/sley/internal/semver"
 	"github.com/indaco/sley/internal/testutils"
@@ -286,19 +285,19 @@ func TestCLI_BumpAutoCmd_InferredBumpFails(t *testing.T) {
 
 func TestTryInferBumpTypeFromCommitParserPlugin_GetCommitsError(t *testing.T) {
 	testutils.WithMock(func() {
-		// Mock GetCommits to fail
-		originalGetCommits := gitlog.GetCommitsFn
+		// Mock the git commit log reader to fail
+		originalReader := newGitCommitLogReaderFn
 		originalParser := commitparser.GetCommitParserFn
 
-		gitlog.GetCommitsFn = func(since, until string) ([]string, error) {
-			return nil, fmt.Errorf("simulated gitlog error")
+		newGitCommitLogReaderFn = func() semver.GitCommitLogReader {
+			return &semver.MockGitCommitLogReader{Err: fmt.Errorf("simulated gitlog error")}
 		}
 		commitparser.GetCommitParserFn = func() commitparser.CommitParser {
 			return testutils.MockCommitParser{} // Return any parser
 		}
 
 		t.Cleanup(func() {
-			gitlog.GetCommitsFn = originalGetCommits
+			newGitCommitLogReaderFn = originalReader
 			commitparser.GetCommitParserFn = originalParser
 		})
 	}, func() {
@@ -314,8 +313,8 @@ func TestTryInferBumpTypeFromCommitParserPlugin_ParserError(t *testing.T) {
 	testutils.WithMock(
 		func() {
 			// Setup mocks
-			gitlog.GetCommitsFn = func(since, until string) ([]string, error) {
-				return []string{"fix: something"}, nil
+			newGitCommitLogReaderFn = func() semver.GitCommitLogReader {
+				return &semver.MockGitCommitLogReader{Commits: []string{"fix: something"}}
 			}
 			commitparser.GetCommitParserFn = func() commitparser.CommitParser {
 				return testutils.MockCommitParser{Err: fmt.Errorf("parser error")}
@@ -335,8 +334,8 @@ func TestTryInferBumpTypeFromCommitParserPlugin_Success(t *testing.T) {
 	testutils.WithMock(
 		func() {
 			// Setup mocks
-			gitlog.GetCommitsFn = func(since, until string) ([]string, error) {
-				return []string{"feat: add feature"}, nil
+			newGitCommitLogReaderFn = func() semver.GitCommitLogReader {
+				return &semver.MockGitCommitLogReader{Commits: []string{"feat: add feature"}}
 			}
 		},
 		func() {
@@ -15,7 +15,6 @@ import (
 	"github.com/indaco/sley/internal/plugins/auditlog"
 	"github.com/indaco/sley/internal/plugins/changeloggenerator"
 	"github.com/indaco/sley/internal/plugins/commitparser"
-	"github.com/indaco/sley/internal/plugins/commitparser/gitlog"
 	"github.com/indaco/sley/internal/plugins/dependencycheck"
 	"github.com/indaco/sley/internal/plugins/releasegate"
 	"github.com/indaco/sley/internal/plugins/tagmanager"
@@ -372,7 +371,7 @@ func TestCLI_BumpAutoCmd_InferredBump(t *testing.T) {
 	defer func() { tryInferBumpTypeFromCommitParserPluginFn = originalInfer }()
 
 	// Mock the inference to simulate an inferred "minor" bump
-	tryInferBumpTypeFromCommitParserPluginFn = func(since, until string) string {
+	tryInferBumpTypeFromCommitParserPluginFn = func(ctx context.Context, since, until string) string {
 		return "minor"
 	}
 
@@ -466,7 +465,7 @@ func TestCLI_BumpAutoCmd_InferredPromotion(t *testing.T) {
 	originalInfer := tryInferBumpTypeFromCommitParserPluginFn
 	defer func() { tryInferBumpTypeFromCommitParserPluginFn = originalInfer }()
 
-	tryInferBumpTypeFromCommitParserPluginFn = func(since, until string) string {
+	tryInferBumpTypeFromCommitParserPluginFn = func(ctx context.Context, since, until string) string {
 		return "minor"
 	}
 
@@ -494,7 +493,7 @@ func TestCLI_BumpAutoCmd_PromotePreReleaseWithPreserveMeta(t *testing.T) {
 
 	// Override tryInferBumpTypeFromCommitParserPlugin
 	originalInfer := tryInferBumpTypeFromCommitParserPluginFn
-	tryInferBumpTypeFromCommitParserPluginFn = func(since, until string) string {
+	tryInferBumpTypeFromCommitParserPluginFn = func(ctx context.Context, since, until string) string {
 		return "minor" // Force a non-empty inference so that promotePreRelease is called
 	}
 	t.Cleanup(func() { tryInferBumpTypeFromCommitParserPluginFn = originalInfer })
@@ -529,7 +528,7 @@ func TestCLI_BumpAutoCmd_InferredBumpFails(t *testing.T) {
 	}
 
 	// Force inference to return something
-	tryInferBumpTypeFromCommitParserPluginFn = func(since, until string) string {
+	tryInferBumpTypeFromCommitParserPluginFn = func(ctx context.Context, since, until string) string {
 		return "minor"
 	}
 
@@ -553,23 +552,23 @@ func TestCLI_BumpAutoCmd_InferredBumpFails(t *testing.T) {
 
 func TestTryInferBumpTypeFromCommitParserPlugin_GetCommitsError(t *testing.T) {
 	testutils.WithMock(func() {
-		// Mock GetCommits to fail
-		originalGetCommits := gitlog.GetCommitsFn
+		// Mock the git commit log reader to fail
+		originalReader := newGitCommitLogReaderFn
 		originalParser := commitparser.GetCommitParserFn
 
-		gitlog.GetCommitsFn = func(since, until string) ([]string, error) {
-			return nil, fmt.Errorf("simulated gitlog error")
+		newGitCommitLogReaderFn = func() semver.GitCommitLogReader {
+			return &semver.MockGitCommitLogReader{Err: fmt.Errorf("simulated gitlog error")}
 		}
 		commitparser.GetCommitParserFn = func() commitparser.CommitParser {
 			return testutils.MockCommitParser{} // Return any parser
 		}
 
 		t.Cleanup(func() {
-			gitlog.GetCommitsFn = originalGetCommits
+			newGitCommitLogReaderFn = originalReader
 			commitparser.GetCommitParserFn = originalParser
 		})
 	}, func() {
-		label := tryInferBumpTypeFromCommitParserPlugin("", "")
+		label := tryInferBumpTypeFromCommitParserPlugin(context.Background(), "", "")
 		if label != "" {
 			t.Errorf("expected empty label on gitlog error, got %q", label)
 		}
@@ -580,15 +579,15 @@ func TestTryInferBumpTypeFromCommitParserPlugin_ParserError(t *testing.T) {
 	testutils.WithMock(
 		func() {
 			// Setup mocks
-			gitlog.GetCommitsFn = func(since, until string) ([]string, error) {
-				return []string{"fix: something"}, nil
+			newGitCommitLogReaderFn = func() semver.GitCommitLogReader {
+				return &semver.MockGitCommitLogReader{Commits: []string{"fix: something"}}
 			}
 			commitparser.GetCommitParserFn = func() commitparser.CommitParser {
 				return testutils.MockCommitParser{Err: fmt.Errorf("parser error")}
 			}
 		},
 		func() {
-			label := tryInferBumpTypeFromCommitParserPlugin("", "")
+			label := tryInferBumpTypeFromCommitParserPlugin(context.Background(), "", "")
 			if label != "" {
 				t.Errorf("expected empty label on parser error, got %q", label)
 			}
@@ -600,15 +599,15 @@ func TestTryInferBumpTypeFromCommitParserPlugin_Success(t *testing.T) {
 	testutils.WithMock(
 		func() {
 			// Setup mocks
-			gitlog.GetCommitsFn = func(since, until string) ([]string, error) {
-				return []string{"feat: add feature"}, nil
+			newGitCommitLogReaderFn = func() semver.GitCommitLogReader {
+				return &semver.MockGitCommitLogReader{Commits: []string{"feat: add feature"}}
 			}
 			commitparser.GetCommitParserFn = func() commitparser.CommitParser {
 				return testutils.MockCommitParser{Label: "minor"}
 			}
 		},
 		func() {
-			label := tryInferBumpTypeFromCommitParserPlugin("", "")
+			label := tryInferBumpTypeFromCommitParserPlugin(context.Background(), "", "")
 			if label != "minor" {
 				t.Errorf("expected label 'minor', got %q", label)
 			}
@@ -1232,9 +1231,9 @@ func TestDetermineBumpType(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tryInferBumpTypeFromChangelogParserPluginFn = func() string { return tt.mockChangelog }
-			tryInferBumpTypeFromCommitParserPluginFn = func(since, until string) string { return tt.mockCommit }
+			tryInferBumpTypeFromCommitParserPluginFn = func(ctx context.Context, since, until string) string { return tt.mockCommit }
 
-			result := determineBumpType(tt.label, tt.disableInfer, "", "")
+			result := determineBumpType(context.Background(), tt.label, tt.disableInfer, "", "")
 
 			if string(result) != tt.expected {
 				t.Errorf("expected %q, got %q", tt.expected, string(result))
@@ -1305,7 +1304,7 @@ func TestGetNextVersion(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result, err := getNextVersion(tt.current, tt.label, tt.disableInfer, "", "", false)
+			result, err := getNextVersion(context.Background(), tt.current, tt.label, tt.disableInfer, "", "", false)
 			if tt.expectError {
 				if err == nil {
 					t.Error("expected error, got nil")
@@ -0,0 +1,193 @@
+// Package releasecmd implements the "sley release" command: building and
+// applying a dependency-ordered multi-module release plan.
+package releasecmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/indaco/sley/internal/config"
+	"github.com/indaco/sley/internal/core"
+	"github.com/indaco/sley/internal/operations"
+	"github.com/indaco/sley/internal/plugins/tagmanager"
+	"github.com/indaco/sley/internal/printer"
+	"github.com/indaco/sley/internal/semver"
+	"github.com/indaco/sley/internal/workspace"
+	"github.com/urfave/cli/v3"
+)
+
+// Run returns the "release" command.
+func Run(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:      "release",
+		Usage:     "Plan and apply a dependency-ordered multi-module release",
+		UsageText: "sley release [--plan|--apply] [--bump-type patch|minor|major|release] [--output path]",
+		Description: `Release discovers every module in the workspace, builds a dependency DAG from
+each module's go.mod "require" block against sibling module paths, and orders
+the modules so upstream dependencies are released before the downstream
+modules that require them.
+
+Use --plan to preview the plan as JSON (modules, new versions, edges, skip
+reasons) without changing anything. Use --apply to execute it: bump each
+module's .version file, rewrite downstream go.mod requires to the freshly
+bumped versions, and tag each module as it's released.
+
+Cycles in the dependency graph are an error unless the module paths involved
+are listed under workspace.release.cycle-breakers in the config file, in
+which case the offending edges are dropped and a warning is recorded.`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "bump-type",
+				Usage: "Bump type to apply to every module: patch, minor, major, release",
+				Value: "patch",
+			},
+			&cli.BoolFlag{
+				Name:  "plan",
+				Usage: "Print the release plan as JSON without changing anything",
+			},
+			&cli.BoolFlag{
+				Name:  "apply",
+				Usage: "Execute the release plan",
+			},
+			&cli.StringFlag{
+				Name:  "output",
+				Usage: "Write the JSON plan to a file instead of stdout (only with --plan)",
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			return runRelease(ctx, cmd, cfg)
+		},
+	}
+}
+
+// runRelease validates the --plan/--apply flags and dispatches to the
+// matching mode.
+func runRelease(ctx context.Context, cmd *cli.Command, cfg *config.Config) error {
+	wantPlan := cmd.Bool("plan")
+	wantApply := cmd.Bool("apply")
+
+	if wantPlan == wantApply {
+		return fmt.Errorf("specify exactly one of --plan or --apply")
+	}
+
+	bumpType := operations.BumpType(cmd.String("bump-type"))
+
+	modules, err := discoverWorkspaceModules(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	var cycleBreakers []string
+	if cfg.Workspace != nil && cfg.Workspace.Release != nil {
+		cycleBreakers = cfg.Workspace.Release.CycleBreakers
+	}
+
+	fs := core.NewOSFileSystem()
+	op := operations.NewReleasePlanOperation(fs)
+
+	releasePlan, err := op.BuildPlan(ctx, modules, bumpType, cycleBreakers)
+	if err != nil {
+		return fmt.Errorf("failed to build release plan: %w", err)
+	}
+
+	if wantPlan {
+		return printReleasePlan(releasePlan, cmd.String("output"))
+	}
+
+	return applyReleasePlan(ctx, op, releasePlan)
+}
+
+// discoverWorkspaceModules detects every module in the current working
+// directory's workspace, normalizing the single-module case to a one-element
+// slice so callers don't need to special-case it.
+func discoverWorkspaceModules(ctx context.Context, cfg *config.Config) ([]*workspace.Module, error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get working directory: %w", err)
+	}
+
+	fs := core.NewOSFileSystem()
+	detector := workspace.NewDetector(fs, cfg)
+
+	detectedCtx, err := detector.DetectContext(ctx, cwd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect workspace context: %w", err)
+	}
+
+	switch detectedCtx.Mode {
+	case workspace.MultiModule:
+		return detectedCtx.Modules, nil
+	case workspace.SingleModule:
+		dir := filepath.Dir(detectedCtx.Path)
+		return []*workspace.Module{
+			{Name: filepath.Base(dir), Path: detectedCtx.Path, Dir: dir},
+		}, nil
+	default:
+		return nil, fmt.Errorf("no modules found in workspace")
+	}
+}
+
+// printReleasePlan marshals plan as indented JSON and writes it to output,
+// or stdout when output is empty.
+func printReleasePlan(plan *operations.ReleasePlan, output string) error {
+	data, err := json.MarshalIndent(plan, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal release plan: %w", err)
+	}
+
+	if output == "" {
+		fmt.Println(string(data))
+		return nil
+	}
+
+	if err := os.WriteFile(output, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write release plan to %s: %w", output, err)
+	}
+	printer.PrintSuccess(fmt.Sprintf("Wrote release plan to %s", output))
+	return nil
+}
+
+// applyReleasePlan executes plan, tagging each released module via the
+// tagmanager plugin (when enabled) as it's released.
+func applyReleasePlan(ctx context.Context, op *operations.ReleasePlanOperation, plan *operations.ReleasePlan) error {
+	err := op.Apply(ctx, plan, operations.ApplyOptions{
+		OnModuleReleased: func(entry operations.ReleasePlanEntry) error {
+			printer.PrintSuccess(fmt.Sprintf("Released %s -> %s", entry.ModulePath, entry.NewVersion))
+			return tagReleasedModule(entry)
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to apply release plan: %w", err)
+	}
+	return nil
+}
+
+// tagReleasedModule creates a git tag for a released module if the tag
+// manager plugin is enabled, mirroring bumpcmd's createTagAfterBump.
+func tagReleasedModule(entry operations.ReleasePlanEntry) error {
+	tm := tagmanager.GetTagManagerFn()
+	if tm == nil {
+		return nil
+	}
+
+	plugin, ok := tm.(*tagmanager.TagManagerPlugin)
+	if !ok || !plugin.IsEnabled() {
+		return nil
+	}
+
+	newVer, err := semver.ParseVersion(entry.NewVersion)
+	if err != nil {
+		return fmt.Errorf("invalid version %q for %s: %w", entry.NewVersion, entry.ModulePath, err)
+	}
+
+	message := fmt.Sprintf("Release %s %s", entry.ModulePath, newVer.String())
+	if err := tm.CreateTag(newVer, message); err != nil {
+		return fmt.Errorf("failed to create tag for %s: %w", entry.ModulePath, err)
+	}
+
+	fmt.Printf("Created tag: %s\n", tm.FormatTagName(newVer))
+	return nil
+}
@@ -0,0 +1,152 @@
+package extensionmgr
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+
+	"github.com/indaco/sley/internal/apperrors"
+)
+
+// HookManifestFile is the well-known manifest name looked up at the root of
+// an extension directory, analogous to the OCI runtime-hooks 1.0.0 schema.
+const HookManifestFile = "hook.json"
+
+// HookManifest describes a single hook declared by an extension: which
+// process to spawn, which stages it participates in, and the conditions
+// under which it should actually be invoked for a given stage.
+type HookManifest struct {
+	Version string      `json:"version"`
+	Hook    HookCommand `json:"hook"`
+	Stages  []string    `json:"stages"`
+	When    *HookWhen   `json:"when,omitempty"`
+}
+
+// HookCommand is the process to spawn for a manifest-declared hook.
+type HookCommand struct {
+	Path string   `json:"path"`
+	Args []string `json:"args,omitempty"`
+	Env  []string `json:"env,omitempty"`
+
+	// Protocol declares the output protocol the hook speaks on stdout:
+	// ProtocolJSON (the default, a single HookOutput object) or
+	// ProtocolNDJSON (a stream of HookEvent records via ExecuteNDJSONHook).
+	Protocol string `json:"protocol,omitempty"`
+}
+
+// HookWhen gates hook activation for a given stage. Always takes precedence;
+// otherwise the hook runs if any Commands pattern matches the invoked sley
+// subcommand, or any Annotations pattern matches the corresponding HookInput
+// metadata entry.
+type HookWhen struct {
+	Always      bool              `json:"always,omitempty"`
+	Commands    []string          `json:"commands,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// loadHookManifest reads and parses extensionPath's HookManifestFile, if one
+// exists. It returns (nil, nil) when no manifest file is present, so callers
+// can distinguish "extension has no manifest" from a malformed one.
+func loadHookManifest(extensionPath string) (*HookManifest, error) {
+	data, err := os.ReadFile(filepath.Join(extensionPath, HookManifestFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return Read(data)
+}
+
+// Read parses raw JSON bytes into a HookManifest and validates it.
+func Read(data []byte) (*HookManifest, error) {
+	var m HookManifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("%w: failed to parse hook manifest: %v", apperrors.ErrExtension, err)
+	}
+
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+// Validate ensures the manifest carries everything ExecuteExtensionHook
+// needs to spawn and gate the hook process, surfacing schema errors as
+// apperrors.ErrExtension.
+func (m *HookManifest) Validate() error {
+	if m.Version == "" {
+		return fmt.Errorf("%w: hook manifest: missing 'version'", apperrors.ErrExtension)
+	}
+	if m.Hook.Path == "" {
+		return fmt.Errorf("%w: hook manifest: missing 'hook.path'", apperrors.ErrExtension)
+	}
+	if len(m.Stages) == 0 {
+		return fmt.Errorf("%w: hook manifest: at least one stage is required", apperrors.ErrExtension)
+	}
+
+	if m.When == nil {
+		return nil
+	}
+
+	for _, pattern := range m.When.Commands {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("%w: hook manifest: invalid 'when.commands' pattern %q: %v", apperrors.ErrExtension, pattern, err)
+		}
+	}
+	for key, pattern := range m.When.Annotations {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("%w: hook manifest: invalid 'when.annotations[%s]' pattern %q: %v", apperrors.ErrExtension, key, pattern, err)
+		}
+	}
+
+	return nil
+}
+
+// SupportsStage reports whether the manifest declares it runs during stage.
+func (m *HookManifest) SupportsStage(stage string) bool {
+	return slices.Contains(m.Stages, stage)
+}
+
+// Matches evaluates the manifest's `when` block for stage against the
+// invoked sley subcommand and the hook input's annotations. It returns
+// false without error if the manifest does not declare stage at all.
+func (m *HookManifest) Matches(stage, command string, annotations map[string]string) (bool, error) {
+	if !m.SupportsStage(stage) {
+		return false, nil
+	}
+	if m.When == nil || m.When.Always {
+		return true, nil
+	}
+
+	for _, pattern := range m.When.Commands {
+		matched, err := regexp.MatchString(pattern, command)
+		if err != nil {
+			return false, fmt.Errorf("%w: hook manifest: invalid 'when.commands' pattern %q: %v", apperrors.ErrExtension, pattern, err)
+		}
+		if matched {
+			return true, nil
+		}
+	}
+
+	for key, pattern := range m.When.Annotations {
+		value, ok := annotations[key]
+		if !ok {
+			continue
+		}
+		matched, err := regexp.MatchString(pattern, value)
+		if err != nil {
+			return false, fmt.Errorf("%w: hook manifest: invalid 'when.annotations[%s]' pattern %q: %v", apperrors.ErrExtension, key, pattern, err)
+		}
+		if matched {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,185 @@
+package extensionmgr
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRead_ValidManifest(t *testing.T) {
+	data := []byte(`{
+		"version": "1.0.0",
+		"hook": {"path": "hook.sh", "args": ["--ci"], "env": ["FOO=bar"]},
+		"stages": ["pre-validate", "post-build"],
+		"when": {"commands": ["^bump$"], "annotations": {"team": "^platform$"}}
+	}`)
+
+	m, err := Read(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if m.Hook.Path != "hook.sh" {
+		t.Errorf("expected hook.path 'hook.sh', got %q", m.Hook.Path)
+	}
+	if !m.SupportsStage("pre-validate") {
+		t.Error("expected manifest to support 'pre-validate'")
+	}
+	if m.SupportsStage("pre-publish") {
+		t.Error("did not expect manifest to support 'pre-publish'")
+	}
+}
+
+func TestRead_ProtocolField(t *testing.T) {
+	data := []byte(`{
+		"version": "1.0.0",
+		"hook": {"path": "stream.sh", "protocol": "ndjson"},
+		"stages": ["pre-bump"]
+	}`)
+
+	m, err := Read(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if m.Hook.Protocol != ProtocolNDJSON {
+		t.Errorf("expected hook.protocol %q, got %q", ProtocolNDJSON, m.Hook.Protocol)
+	}
+}
+
+func TestRead_InvalidJSON(t *testing.T) {
+	_, err := Read([]byte(`{not json`))
+	if err == nil {
+		t.Fatal("expected error for malformed JSON")
+	}
+	if !strings.Contains(err.Error(), "failed to parse hook manifest") {
+		t.Errorf("expected parse error, got %v", err)
+	}
+}
+
+func TestValidate_MissingFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		manifest HookManifest
+		wantErr  string
+	}{
+		{
+			name:     "missing version",
+			manifest: HookManifest{Hook: HookCommand{Path: "hook.sh"}, Stages: []string{"pre-validate"}},
+			wantErr:  "missing 'version'",
+		},
+		{
+			name:     "missing hook path",
+			manifest: HookManifest{Version: "1.0.0", Stages: []string{"pre-validate"}},
+			wantErr:  "missing 'hook.path'",
+		},
+		{
+			name:     "missing stages",
+			manifest: HookManifest{Version: "1.0.0", Hook: HookCommand{Path: "hook.sh"}},
+			wantErr:  "at least one stage is required",
+		},
+		{
+			name: "invalid commands pattern",
+			manifest: HookManifest{
+				Version: "1.0.0",
+				Hook:    HookCommand{Path: "hook.sh"},
+				Stages:  []string{"pre-validate"},
+				When:    &HookWhen{Commands: []string{"("}},
+			},
+			wantErr: "invalid 'when.commands' pattern",
+		},
+		{
+			name: "invalid annotations pattern",
+			manifest: HookManifest{
+				Version: "1.0.0",
+				Hook:    HookCommand{Path: "hook.sh"},
+				Stages:  []string{"pre-validate"},
+				When:    &HookWhen{Annotations: map[string]string{"team": "("}},
+			},
+			wantErr: "invalid 'when.annotations[team]' pattern",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.manifest.Validate()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestHookManifest_Matches(t *testing.T) {
+	tests := []struct {
+		name        string
+		manifest    HookManifest
+		stage       string
+		command     string
+		annotations map[string]string
+		want        bool
+	}{
+		{
+			name:     "stage not declared",
+			manifest: HookManifest{Stages: []string{"post-build"}},
+			stage:    "pre-validate",
+			want:     false,
+		},
+		{
+			name:     "no when block defaults to always",
+			manifest: HookManifest{Stages: []string{"pre-validate"}},
+			stage:    "pre-validate",
+			want:     true,
+		},
+		{
+			name:     "when.always overrides predicates",
+			manifest: HookManifest{Stages: []string{"pre-validate"}, When: &HookWhen{Always: true}},
+			stage:    "pre-validate",
+			want:     true,
+		},
+		{
+			name:     "command pattern matches",
+			manifest: HookManifest{Stages: []string{"pre-validate"}, When: &HookWhen{Commands: []string{"^bump$"}}},
+			stage:    "pre-validate",
+			command:  "bump",
+			want:     true,
+		},
+		{
+			name:     "command pattern does not match",
+			manifest: HookManifest{Stages: []string{"pre-validate"}, When: &HookWhen{Commands: []string{"^bump$"}}},
+			stage:    "pre-validate",
+			command:  "set",
+			want:     false,
+		},
+		{
+			name:        "annotation pattern matches",
+			manifest:    HookManifest{Stages: []string{"pre-validate"}, When: &HookWhen{Annotations: map[string]string{"team": "^platform$"}}},
+			stage:       "pre-validate",
+			annotations: map[string]string{"team": "platform"},
+			want:        true,
+		},
+		{
+			name:        "annotation key absent never matches",
+			manifest:    HookManifest{Stages: []string{"pre-validate"}, When: &HookWhen{Annotations: map[string]string{"team": "^platform$"}}},
+			stage:       "pre-validate",
+			annotations: map[string]string{"other": "platform"},
+			want:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.manifest.Matches(tt.stage, tt.command, tt.annotations)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHookManifest_Matches_InvalidPattern(t *testing.T) {
+	m := HookManifest{Stages: []string{"pre-validate"}, When: &HookWhen{Commands: []string{"("}}}
+	if _, err := m.Matches("pre-validate", "bump", nil); err == nil {
+		t.Fatal("expected error for invalid regex pattern")
+	}
+}
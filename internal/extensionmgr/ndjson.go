@@ -0,0 +1,172 @@
+package extensionmgr
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/indaco/sley/internal/core"
+)
+
+// Supported HookInput.Protocol values. ProtocolJSON is the default: a single
+// HookOutput object read from stdout once the script exits. ProtocolNDJSON
+// opts into the streaming protocol implemented by ExecuteNDJSONHook.
+const (
+	ProtocolJSON   = "json"
+	ProtocolNDJSON = "ndjson"
+)
+
+// NDJSON event types, one per line emitted by an ndjson-protocol hook.
+const (
+	HookEventLog      = "log"
+	HookEventProgress = "progress"
+	HookEventResult   = "result"
+)
+
+// DefaultMaxNDJSONLineSize bounds the size of a single ndjson record.
+// Scripts emitting a line larger than this are treated as misbehaving.
+const DefaultMaxNDJSONLineSize = 1024 * 1024 // 1MB
+
+// HookEvent is a single newline-delimited JSON record read from an
+// ndjson-protocol hook's stdout. Fields are a superset of the three record
+// shapes the protocol defines (log, progress, result); only the fields
+// relevant to Type are populated for a given record.
+type HookEvent struct {
+	Type    string         `json:"type"`
+	Level   string         `json:"level,omitempty"`
+	Msg     string         `json:"msg,omitempty"`
+	Pct     int            `json:"pct,omitempty"`
+	Success bool           `json:"success,omitempty"`
+	Message string         `json:"message,omitempty"`
+	Data    map[string]any `json:"data,omitempty"`
+}
+
+// HookEventHandler receives log and progress events as an ndjson-protocol
+// hook streams them, so callers (e.g. sley's CLI) can render progress bars
+// or forward logs to their own logger while the hook is still running.
+type HookEventHandler interface {
+	HandleLog(level, msg string)
+	HandleProgress(pct int)
+}
+
+// MaxNDJSONLineSize overrides DefaultMaxNDJSONLineSize for this executor's
+// ndjson scanner buffer. Zero means use the default.
+func (e *ScriptExecutor) maxNDJSONLineSize() int {
+	if e.MaxNDJSONLineSize > 0 {
+		return e.MaxNDJSONLineSize
+	}
+	return DefaultMaxNDJSONLineSize
+}
+
+// ExecuteNDJSONHook runs scriptPath with input.Protocol forced to
+// ProtocolNDJSON, reading the hook's stdout line-by-line via bufio.Scanner.
+// "log" and "progress" records are dispatched to handler as they arrive;
+// handler may be nil to discard them. The script must eventually emit a
+// terminal "result" record, which is returned as a *HookOutput exactly as
+// Execute would return one for the JSON protocol. A stream that ends (EOF
+// or process exit) without a "result" record is treated as an error.
+func (e *ScriptExecutor) ExecuteNDJSONHook(ctx context.Context, scriptPath string, input *HookInput, handler HookEventHandler) (*HookOutput, error) {
+	cleanPath := filepath.Clean(scriptPath)
+
+	absPath, err := filepath.Abs(cleanPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve script path %s: %w", scriptPath, err)
+	}
+
+	info, err := os.Stat(absPath)
+	if err != nil {
+		return nil, fmt.Errorf("script not found at %s: %w", absPath, err)
+	}
+	if info.IsDir() {
+		return nil, fmt.Errorf("script path is a directory: %s", absPath)
+	}
+	if info.Mode()&core.PermExecutable == 0 {
+		return nil, fmt.Errorf("script is not executable: %s", absPath)
+	}
+
+	negotiated := *input
+	negotiated.Protocol = ProtocolNDJSON
+
+	inputJSON, err := json.Marshal(&negotiated)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize input: %w", err)
+	}
+
+	execCtx, cancel := context.WithTimeout(ctx, e.Timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(execCtx, absPath)
+	cmd.Stdin = bytes.NewReader(inputJSON)
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to attach stdout pipe: %w", err)
+	}
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("script execution failed to start: %w\nstderr: %s", err, stderr.String())
+	}
+
+	scanner := bufio.NewScanner(stdout)
+	scanner.Buffer(make([]byte, 0, 4096), e.maxNDJSONLineSize())
+
+	var result *HookOutput
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		var event HookEvent
+		if err := json.Unmarshal(line, &event); err != nil {
+			// Tolerate stray non-protocol output on stdout; only
+			// well-formed records participate in the protocol.
+			continue
+		}
+
+		switch event.Type {
+		case HookEventLog:
+			if handler != nil {
+				handler.HandleLog(event.Level, event.Msg)
+			}
+		case HookEventProgress:
+			if handler != nil {
+				handler.HandleProgress(event.Pct)
+			}
+		case HookEventResult:
+			result = &HookOutput{Success: event.Success, Message: event.Message, Data: event.Data}
+		}
+	}
+	scanErr := scanner.Err()
+
+	waitErr := cmd.Wait()
+
+	if scanErr != nil {
+		return nil, fmt.Errorf("failed to read ndjson output: %w\nstderr: %s", scanErr, stderr.String())
+	}
+
+	if waitErr != nil {
+		if execCtx.Err() == context.DeadlineExceeded {
+			return nil, fmt.Errorf("script execution timeout after %v: %s", e.Timeout, stderr.String())
+		}
+		return nil, fmt.Errorf("script execution failed: %w\nstderr: %s", waitErr, stderr.String())
+	}
+
+	if result == nil {
+		return nil, fmt.Errorf("ndjson stream from %s ended without a terminal \"result\" record", absPath)
+	}
+
+	if !result.Success {
+		return result, fmt.Errorf("script reported failure: %s", result.Message)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,173 @@
+package extensionmgr
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+type recordingHandler struct {
+	logs     []string
+	progress []int
+}
+
+func (h *recordingHandler) HandleLog(level, msg string) {
+	h.logs = append(h.logs, level+":"+msg)
+}
+
+func (h *recordingHandler) HandleProgress(pct int) {
+	h.progress = append(h.progress, pct)
+}
+
+func writeNDJSONScript(t *testing.T, body string) string {
+	t.Helper()
+	tmpDir := t.TempDir()
+	scriptPath := filepath.Join(tmpDir, "hook.sh")
+	script := "#!/bin/sh\nread input\n" + body
+	if err := os.WriteFile(scriptPath, []byte(script), 0755); err != nil {
+		t.Fatalf("failed to create test script: %v", err)
+	}
+	return scriptPath
+}
+
+func TestExecuteNDJSONHook_LogsProgressAndResult(t *testing.T) {
+	scriptPath := writeNDJSONScript(t, `
+echo '{"type":"log","level":"info","msg":"starting"}'
+echo '{"type":"progress","pct":50}'
+echo '{"type":"result","success":true,"message":"done"}'
+`)
+
+	executor := NewScriptExecutor()
+	handler := &recordingHandler{}
+	input := &HookInput{Hook: "pre-bump", Version: "1.0.0"}
+
+	output, err := executor.ExecuteNDJSONHook(context.Background(), scriptPath, input, handler)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !output.Success || output.Message != "done" {
+		t.Errorf("unexpected output: %+v", output)
+	}
+	if len(handler.logs) != 1 || handler.logs[0] != "info:starting" {
+		t.Errorf("expected one log event, got %v", handler.logs)
+	}
+	if len(handler.progress) != 1 || handler.progress[0] != 50 {
+		t.Errorf("expected one progress event of 50, got %v", handler.progress)
+	}
+}
+
+func TestExecuteNDJSONHook_MissingTerminalRecord(t *testing.T) {
+	scriptPath := writeNDJSONScript(t, `
+echo '{"type":"log","level":"info","msg":"starting"}'
+`)
+
+	executor := NewScriptExecutor()
+	input := &HookInput{Hook: "pre-bump", Version: "1.0.0"}
+
+	_, err := executor.ExecuteNDJSONHook(context.Background(), scriptPath, input, nil)
+	if err == nil {
+		t.Fatal("expected error for missing terminal record")
+	}
+	if !strings.Contains(err.Error(), "without a terminal") {
+		t.Errorf("expected missing terminal record error, got %v", err)
+	}
+}
+
+func TestExecuteNDJSONHook_TolerateMalformedLine(t *testing.T) {
+	scriptPath := writeNDJSONScript(t, `
+echo 'not json at all'
+echo '{"type":"result","success":true,"message":"done"}'
+`)
+
+	executor := NewScriptExecutor()
+	input := &HookInput{Hook: "pre-bump", Version: "1.0.0"}
+
+	output, err := executor.ExecuteNDJSONHook(context.Background(), scriptPath, input, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !output.Success {
+		t.Error("expected success=true despite stray non-protocol line")
+	}
+}
+
+func TestExecuteNDJSONHook_OversizedLine(t *testing.T) {
+	scriptPath := writeNDJSONScript(t, `
+head -c 200 /dev/zero | tr '\0' 'x'
+echo
+echo '{"type":"result","success":true}'
+`)
+
+	executor := NewScriptExecutor()
+	executor.MaxNDJSONLineSize = 64
+	input := &HookInput{Hook: "pre-bump", Version: "1.0.0"}
+
+	_, err := executor.ExecuteNDJSONHook(context.Background(), scriptPath, input, nil)
+	if err == nil {
+		t.Fatal("expected error for oversized ndjson line")
+	}
+	if !strings.Contains(err.Error(), "failed to read ndjson output") {
+		t.Errorf("expected scanner overflow error, got %v", err)
+	}
+}
+
+func TestExecuteNDJSONHook_ScriptFailureResult(t *testing.T) {
+	scriptPath := writeNDJSONScript(t, `
+echo '{"type":"result","success":false,"message":"validation failed"}'
+`)
+
+	executor := NewScriptExecutor()
+	input := &HookInput{Hook: "validate", Version: "1.0.0"}
+
+	output, err := executor.ExecuteNDJSONHook(context.Background(), scriptPath, input, nil)
+	if err == nil {
+		t.Fatal("expected error for failed result")
+	}
+	if output == nil || output.Success {
+		t.Errorf("expected non-nil failed output, got %+v", output)
+	}
+}
+
+func TestExecuteNDJSONHook_NegotiatesProtocolField(t *testing.T) {
+	// The script echoes back the received protocol field so we can assert
+	// it was negotiated to "ndjson" regardless of the caller's input.
+	scriptPath := writeNDJSONScript(t, `
+protocol=$(echo "$input" | grep -o '"protocol":"[^"]*"' | cut -d'"' -f4)
+echo "{\"type\":\"result\",\"success\":true,\"message\":\"$protocol\"}"
+`)
+
+	executor := NewScriptExecutor()
+	input := &HookInput{Hook: "pre-bump", Version: "1.0.0", Protocol: ProtocolJSON}
+
+	output, err := executor.ExecuteNDJSONHook(context.Background(), scriptPath, input, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.Message != ProtocolNDJSON {
+		t.Errorf("expected negotiated protocol %q, got %q", ProtocolNDJSON, output.Message)
+	}
+	if input.Protocol != ProtocolJSON {
+		t.Error("expected caller's HookInput to be left untouched")
+	}
+}
+
+func TestExecuteNDJSONHook_Timeout(t *testing.T) {
+	scriptPath := writeNDJSONScript(t, `
+sleep 5
+echo '{"type":"result","success":true}'
+`)
+
+	executor := NewScriptExecutorWithTimeout(100 * time.Millisecond)
+	input := &HookInput{Hook: "pre-bump", Version: "1.0.0"}
+
+	_, err := executor.ExecuteNDJSONHook(context.Background(), scriptPath, input, nil)
+	if err == nil {
+		t.Fatal("expected timeout error")
+	}
+	if !strings.Contains(err.Error(), "timeout") {
+		t.Errorf("expected timeout error, got %v", err)
+	}
+}
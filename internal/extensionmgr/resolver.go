@@ -0,0 +1,111 @@
+package extensionmgr
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// projectLocalExtensionsDir is the project-local extension bundle directory,
+// checked last so a repo can ship extensions without touching the user's
+// XDG directories.
+const projectLocalExtensionsDir = ".sley/extensions"
+
+// userHomeDirForResolveFn and getwdFn are indirections over os.UserHomeDir
+// and os.Getwd so tests can stub them without touching the real user
+// environment.
+var (
+	userHomeDirForResolveFn = os.UserHomeDir
+	getwdFn                 = os.Getwd
+)
+
+// ResolveExtensionDir resolves a bare extension name (no path separators
+// implied) to the directory it lives in, searching XDG-style locations in
+// priority order:
+//
+//  1. $XDG_DATA_HOME/sley/extensions/<name> (default ~/.local/share/sley/extensions/<name>)
+//  2. each $XDG_DATA_DIRS entry joined with sley/extensions/<name> (default /usr/local/share:/usr/share)
+//  3. ./.sley/extensions/<name> relative to the current working directory
+//
+// The first candidate that exists as a directory wins. ResolveExtensionDir
+// returns an error if name cannot be found in any of these locations.
+func ResolveExtensionDir(name string) (string, error) {
+	for _, dir := range candidateExtensionDirs() {
+		path := filepath.Join(dir, name)
+		if info, err := os.Stat(path); err == nil && info.IsDir() {
+			return path, nil
+		}
+	}
+
+	return "", fmt.Errorf("extension %q not found in XDG data directories or %s", name, projectLocalExtensionsDir)
+}
+
+// resolveExtensionPath resolves path through ResolveExtensionDir when it
+// looks like a bare extension name (no path separator); a literal directory
+// path is returned unchanged. ResolveExtensionDir errors are swallowed here —
+// callers fall back to the original value so path-configured extensions keep
+// working exactly as before.
+func resolveExtensionPath(path string) string {
+	if strings.ContainsRune(path, filepath.Separator) {
+		return path
+	}
+	if dir, err := ResolveExtensionDir(path); err == nil {
+		return dir
+	}
+	return path
+}
+
+// candidateExtensionDirs builds the ordered list of "sley/extensions"
+// directories to search, without yet joining the extension name.
+func candidateExtensionDirs() []string {
+	dirs := []string{filepath.Join(xdgDataHome(), "sley", "extensions")}
+
+	for _, base := range filepath.SplitList(xdgDataDirs()) {
+		if base == "" {
+			continue
+		}
+		dirs = append(dirs, filepath.Join(base, "sley", "extensions"))
+	}
+
+	if cwd, err := getwdFn(); err == nil {
+		dirs = append(dirs, filepath.Join(cwd, projectLocalExtensionsDir))
+	}
+
+	return dedupe(dirs)
+}
+
+// xdgDataHome returns $XDG_DATA_HOME, falling back to ~/.local/share per the
+// XDG base directory specification.
+func xdgDataHome() string {
+	if v := os.Getenv("XDG_DATA_HOME"); v != "" {
+		return v
+	}
+	if home, err := userHomeDirForResolveFn(); err == nil {
+		return filepath.Join(home, ".local", "share")
+	}
+	return ""
+}
+
+// xdgDataDirs returns $XDG_DATA_DIRS, falling back to /usr/local/share:/usr/share.
+func xdgDataDirs() string {
+	if v := os.Getenv("XDG_DATA_DIRS"); v != "" {
+		return v
+	}
+	return "/usr/local/share:/usr/share"
+}
+
+// dedupe removes duplicate entries while preserving first-seen order, so a
+// directory listed twice in $XDG_DATA_DIRS is only searched once.
+func dedupe(dirs []string) []string {
+	seen := make(map[string]bool, len(dirs))
+	result := make([]string, 0, len(dirs))
+	for _, dir := range dirs {
+		if seen[dir] {
+			continue
+		}
+		seen[dir] = true
+		result = append(result, dir)
+	}
+	return result
+}
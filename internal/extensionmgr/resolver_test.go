@@ -0,0 +1,183 @@
+package extensionmgr
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestResolveExtensionDir_XDGDataHome(t *testing.T) {
+	dataHome := t.TempDir()
+	extDir := filepath.Join(dataHome, "sley", "extensions", "my-ext")
+	if err := os.MkdirAll(extDir, 0755); err != nil {
+		t.Fatalf("failed to create extension dir: %v", err)
+	}
+
+	t.Setenv("XDG_DATA_HOME", dataHome)
+	t.Setenv("XDG_DATA_DIRS", "")
+
+	got, err := ResolveExtensionDir("my-ext")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != extDir {
+		t.Errorf("expected %q, got %q", extDir, got)
+	}
+}
+
+func TestResolveExtensionDir_XDGDataHome_Precedence(t *testing.T) {
+	dataHome := t.TempDir()
+	dataDir := t.TempDir()
+
+	homeExt := filepath.Join(dataHome, "sley", "extensions", "my-ext")
+	dataDirExt := filepath.Join(dataDir, "sley", "extensions", "my-ext")
+	if err := os.MkdirAll(homeExt, 0755); err != nil {
+		t.Fatalf("failed to create extension dir: %v", err)
+	}
+	if err := os.MkdirAll(dataDirExt, 0755); err != nil {
+		t.Fatalf("failed to create extension dir: %v", err)
+	}
+
+	t.Setenv("XDG_DATA_HOME", dataHome)
+	t.Setenv("XDG_DATA_DIRS", dataDir)
+
+	got, err := ResolveExtensionDir("my-ext")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != homeExt {
+		t.Errorf("expected XDG_DATA_HOME to take precedence, got %q", got)
+	}
+}
+
+func TestResolveExtensionDir_XDGDataDirs(t *testing.T) {
+	dataHome := t.TempDir()
+	dataDirA := t.TempDir()
+	dataDirB := t.TempDir()
+
+	extDir := filepath.Join(dataDirB, "sley", "extensions", "my-ext")
+	if err := os.MkdirAll(extDir, 0755); err != nil {
+		t.Fatalf("failed to create extension dir: %v", err)
+	}
+
+	t.Setenv("XDG_DATA_HOME", dataHome)
+	t.Setenv("XDG_DATA_DIRS", dataDirA+string(filepath.ListSeparator)+dataDirB)
+
+	got, err := ResolveExtensionDir("my-ext")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != extDir {
+		t.Errorf("expected %q, got %q", extDir, got)
+	}
+}
+
+func TestResolveExtensionDir_ProjectLocal(t *testing.T) {
+	dataHome := t.TempDir()
+	projectDir := t.TempDir()
+
+	extDir := filepath.Join(projectDir, projectLocalExtensionsDir, "my-ext")
+	if err := os.MkdirAll(extDir, 0755); err != nil {
+		t.Fatalf("failed to create extension dir: %v", err)
+	}
+
+	t.Setenv("XDG_DATA_HOME", dataHome)
+	t.Setenv("XDG_DATA_DIRS", "")
+
+	oldWd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("failed to get working directory: %v", err)
+	}
+	defer func() {
+		if err := os.Chdir(oldWd); err != nil {
+			t.Errorf("failed to restore working directory: %v", err)
+		}
+	}()
+	if err := os.Chdir(projectDir); err != nil {
+		t.Fatalf("failed to change directory: %v", err)
+	}
+
+	got, err := ResolveExtensionDir("my-ext")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != extDir {
+		t.Errorf("expected %q, got %q", extDir, got)
+	}
+}
+
+func TestResolveExtensionDir_NotFound(t *testing.T) {
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	t.Setenv("XDG_DATA_DIRS", "")
+
+	if _, err := ResolveExtensionDir("does-not-exist"); err == nil {
+		t.Fatal("expected error for missing extension")
+	}
+}
+
+func TestCandidateExtensionDirs_SplitListHandling(t *testing.T) {
+	t.Setenv("XDG_DATA_HOME", "/home/user/.local/share")
+	t.Setenv("XDG_DATA_DIRS", "/usr/local/share::/usr/share:/usr/local/share")
+
+	dirs := candidateExtensionDirs()
+
+	seen := map[string]int{}
+	for _, d := range dirs {
+		seen[d]++
+	}
+	for d, count := range seen {
+		if count > 1 {
+			t.Errorf("expected %q to appear once, got %d", d, count)
+		}
+	}
+
+	want := filepath.Join("/usr/local/share", "sley", "extensions")
+	found := false
+	for _, d := range dirs {
+		if d == want {
+			found = true
+			break
+		}
+	}
+	if !found {
+		t.Errorf("expected %q among candidates, got %v", want, dirs)
+	}
+}
+
+func TestResolveExtensionPath(t *testing.T) {
+	t.Run("literal path is returned unchanged", func(t *testing.T) {
+		if got := resolveExtensionPath("/abs/path/to/ext"); got != "/abs/path/to/ext" {
+			t.Errorf("resolveExtensionPath() = %q, want unchanged path", got)
+		}
+	})
+
+	t.Run("relative path with separator is returned unchanged", func(t *testing.T) {
+		if got := resolveExtensionPath("./local/ext"); got != "./local/ext" {
+			t.Errorf("resolveExtensionPath() = %q, want unchanged path", got)
+		}
+	})
+
+	t.Run("bare name resolves via ResolveExtensionDir", func(t *testing.T) {
+		dataHome := t.TempDir()
+		extDir := filepath.Join(dataHome, "sley", "extensions", "my-ext")
+		if err := os.MkdirAll(extDir, 0755); err != nil {
+			t.Fatalf("failed to create extension dir: %v", err)
+		}
+
+		t.Setenv("XDG_DATA_HOME", dataHome)
+		t.Setenv("XDG_DATA_DIRS", "")
+
+		if got := resolveExtensionPath("my-ext"); got != extDir {
+			t.Errorf("resolveExtensionPath() = %q, want %q", got, extDir)
+		}
+	})
+
+	t.Run("unresolvable bare name falls back to the original value", func(t *testing.T) {
+		t.Setenv("XDG_DATA_HOME", t.TempDir())
+		t.Setenv("XDG_DATA_DIRS", "")
+
+		if got := resolveExtensionPath("nonexistent-ext"); got != "nonexistent-ext" {
+			t.Errorf("resolveExtensionPath() = %q, want original value on resolve failure", got)
+		}
+	})
+}
@@ -28,6 +28,8 @@ type BumpOperation struct {
 	preRelease       string
 	metadata         string
 	preserveMetadata bool
+	commitAnalyzer   *semver.CommitAnalyzer
+	gitLog           semver.GitCommitLogReader
 }
 
 // NewBumpOperation creates a new bump operation.
@@ -41,6 +43,24 @@ func NewBumpOperation(fs core.FileSystem, bumpType BumpType, preRelease, metadat
 	}
 }
 
+// NewBumpOperationWithCommitAnalysis creates a bump operation whose BumpAuto
+// case derives the next version from Conventional Commits history between
+// the last tag and HEAD (via commitAnalyzer and gitLog) before falling back
+// to the heuristic semver.BumpNextFunc when no commit maps to a bump kind.
+func NewBumpOperationWithCommitAnalysis(
+	fs core.FileSystem,
+	bumpType BumpType,
+	preRelease, metadata string,
+	preserveMetadata bool,
+	commitAnalyzer *semver.CommitAnalyzer,
+	gitLog semver.GitCommitLogReader,
+) *BumpOperation {
+	op := NewBumpOperation(fs, bumpType, preRelease, metadata, preserveMetadata)
+	op.commitAnalyzer = commitAnalyzer
+	op.gitLog = gitLog
+	return op
+}
+
 // Execute performs the bump operation on the module.
 func (op *BumpOperation) Execute(ctx context.Context, mod *workspace.Module) error {
 	// Check for context cancellation
@@ -66,40 +86,30 @@ func (op *BumpOperation) Execute(ctx context.Context, mod *workspace.Module) err
 	// Perform the bump based on type
 	var newVer semver.SemVersion
 	switch op.bumpType {
-	case BumpPatch:
-		newVer = semver.SemVersion{
-			Major: currentVer.Major,
-			Minor: currentVer.Minor,
-			Patch: currentVer.Patch + 1,
-		}
-	case BumpMinor:
-		newVer = semver.SemVersion{
-			Major: currentVer.Major,
-			Minor: currentVer.Minor + 1,
-			Patch: 0,
-		}
-	case BumpMajor:
-		newVer = semver.SemVersion{
-			Major: currentVer.Major + 1,
-			Minor: 0,
-			Patch: 0,
+	case BumpAuto:
+		// Prefer a Conventional-Commits-driven bump when commit analysis is
+		// configured and git history actually yields one; otherwise fall
+		// back to the heuristic logic (promote pre-release, else bump patch).
+		commitVer, ok, commitErr := op.bumpFromCommitHistory(ctx, currentVer)
+		if commitErr != nil {
+			return fmt.Errorf("auto bump failed: %w", commitErr)
 		}
-	case BumpRelease:
-		// Release removes pre-release and build metadata
-		newVer = semver.SemVersion{
-			Major: currentVer.Major,
-			Minor: currentVer.Minor,
-			Patch: currentVer.Patch,
+		if ok {
+			newVer = commitVer
+			break
 		}
-	case BumpAuto:
-		// Auto bump uses heuristic-based logic
+
 		autoVer, autoErr := semver.BumpNextFunc(currentVer)
 		if autoErr != nil {
 			return fmt.Errorf("auto bump failed: %w", autoErr)
 		}
 		newVer = autoVer
 	default:
-		return fmt.Errorf("unknown bump type: %s", op.bumpType)
+		computedVer, err := computeBump(op.bumpType, currentVer)
+		if err != nil {
+			return err
+		}
+		newVer = computedVer
 	}
 
 	// Apply pre-release label if provided
@@ -125,7 +135,71 @@ func (op *BumpOperation) Execute(ctx context.Context, mod *workspace.Module) err
 	return nil
 }
 
+// bumpFromCommitHistory derives the next version from Conventional Commits
+// between the last tag and HEAD. It returns ok=false (with no error) when
+// commit analysis isn't configured, git history can't be read, or no commit
+// in range maps to a bump kind — in all of those cases the caller should
+// fall back to semver.BumpNextFunc.
+func (op *BumpOperation) bumpFromCommitHistory(ctx context.Context, current semver.SemVersion) (semver.SemVersion, bool, error) {
+	if op.commitAnalyzer == nil || op.gitLog == nil {
+		return semver.SemVersion{}, false, nil
+	}
+
+	messages, err := op.gitLog.CommitsSinceLastTag(ctx, "", "")
+	if err != nil {
+		return semver.SemVersion{}, false, nil
+	}
+
+	bumpKind, _ := op.commitAnalyzer.Analyze(messages)
+	switch bumpKind {
+	case "major":
+		return semver.SemVersion{Major: current.Major + 1, Minor: 0, Patch: 0}, true, nil
+	case "minor":
+		return semver.SemVersion{Major: current.Major, Minor: current.Minor + 1, Patch: 0}, true, nil
+	case "patch":
+		return semver.SemVersion{Major: current.Major, Minor: current.Minor, Patch: current.Patch + 1}, true, nil
+	default:
+		return semver.SemVersion{}, false, nil
+	}
+}
+
 // Name returns the name of this operation.
 func (op *BumpOperation) Name() string {
 	return fmt.Sprintf("bump %s", op.bumpType)
 }
+
+// computeBump applies a non-auto BumpType to current and returns the result.
+// BumpAuto is handled separately by Execute since it depends on commit
+// history and ctx; shared here so other callers (e.g. ReleasePlanOperation's
+// plan preview) can compute the same deterministic bumps.
+func computeBump(bumpType BumpType, current semver.SemVersion) (semver.SemVersion, error) {
+	switch bumpType {
+	case BumpPatch:
+		return semver.SemVersion{
+			Major: current.Major,
+			Minor: current.Minor,
+			Patch: current.Patch + 1,
+		}, nil
+	case BumpMinor:
+		return semver.SemVersion{
+			Major: current.Major,
+			Minor: current.Minor + 1,
+			Patch: 0,
+		}, nil
+	case BumpMajor:
+		return semver.SemVersion{
+			Major: current.Major + 1,
+			Minor: 0,
+			Patch: 0,
+		}, nil
+	case BumpRelease:
+		// Release removes pre-release and build metadata
+		return semver.SemVersion{
+			Major: current.Major,
+			Minor: current.Minor,
+			Patch: current.Patch,
+		}, nil
+	default:
+		return semver.SemVersion{}, fmt.Errorf("unknown bump type: %s", bumpType)
+	}
+}
@@ -0,0 +1,364 @@
+package operations
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/indaco/sley/internal/core"
+	"github.com/indaco/sley/internal/semver"
+	"github.com/indaco/sley/internal/workspace"
+)
+
+// ReleasePlanEdge represents a dependency edge from a module to a sibling
+// module it requires, as declared in its go.mod "require" block.
+type ReleasePlanEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// ReleasePlanEntry describes a single module's place in a release plan, in
+// the order it should be released.
+type ReleasePlanEntry struct {
+	ModulePath     string `json:"module_path"`
+	Dir            string `json:"dir"`
+	CurrentVersion string `json:"current_version,omitempty"`
+	NewVersion     string `json:"new_version,omitempty"`
+	SkipReason     string `json:"skip_reason,omitempty"`
+}
+
+// ReleasePlan is the result of ReleasePlanOperation.BuildPlan: a dependency-
+// ordered set of modules to release, the edges used to order them, and any
+// warnings raised while breaking known cycles.
+type ReleasePlan struct {
+	Modules  []ReleasePlanEntry `json:"modules"`
+	Edges    []ReleasePlanEdge  `json:"edges"`
+	Warnings []string           `json:"warnings,omitempty"`
+}
+
+// ApplyOptions configures ReleasePlanOperation.Apply.
+type ApplyOptions struct {
+	// OnModuleReleased is invoked after a module's .version file (and any
+	// downstream go.mod requires) have been updated, letting the caller tag
+	// and push the release (e.g. via the tagmanager plugin) without this
+	// package importing plugin packages.
+	OnModuleReleased func(entry ReleasePlanEntry) error
+}
+
+// ReleasePlanOperation builds and executes a dependency-ordered release plan
+// across a workspace's modules, by parsing each module's go.mod "require"
+// block against sibling module paths.
+type ReleasePlanOperation struct {
+	fs core.FileSystem
+}
+
+// NewReleasePlanOperation creates a new release plan operation.
+func NewReleasePlanOperation(fs core.FileSystem) *ReleasePlanOperation {
+	return &ReleasePlanOperation{fs: fs}
+}
+
+// moduleInfo pairs a workspace module with its parsed go.mod data.
+type moduleInfo struct {
+	mod        *workspace.Module
+	modulePath string
+	requires   []string
+}
+
+// BuildPlan reads each module's go.mod, builds a dependency DAG against
+// sibling modules in the workspace, drops edges into any module path listed
+// in cycleBreakers (emitting a warning per dropped edge), topologically
+// orders the remaining graph, and previews each module's next version via
+// bumpType. It does not mutate any files; call Apply to execute the plan.
+//
+// bumpType must be one of BumpPatch, BumpMinor, BumpMajor, or BumpRelease —
+// BumpAuto depends on per-module commit history, so planned modules using it
+// are recorded with a SkipReason instead of a NewVersion.
+func (op *ReleasePlanOperation) BuildPlan(ctx context.Context, modules []*workspace.Module, bumpType BumpType, cycleBreakers []string) (*ReleasePlan, error) {
+	infos := make([]*moduleInfo, 0, len(modules))
+	byPath := make(map[string]*moduleInfo, len(modules))
+
+	for _, mod := range modules {
+		info := &moduleInfo{mod: mod}
+		data, err := op.fs.ReadFile(ctx, filepath.Join(mod.Dir, "go.mod"))
+		if err == nil {
+			info.modulePath, info.requires = parseGoMod(data)
+		}
+		infos = append(infos, info)
+		if info.modulePath != "" {
+			byPath[info.modulePath] = info
+		}
+	}
+
+	breakers := make(map[string]bool, len(cycleBreakers))
+	for _, b := range cycleBreakers {
+		breakers[b] = true
+	}
+
+	depGraph := make(map[string][]string, len(byPath))
+	nodes := make([]string, 0, len(byPath))
+	var edges []ReleasePlanEdge
+	var warnings []string
+
+	for path := range byPath {
+		nodes = append(nodes, path)
+	}
+	sort.Strings(nodes)
+
+	for _, path := range nodes {
+		info := byPath[path]
+		for _, req := range info.requires {
+			if _, isSibling := byPath[req]; !isSibling || req == path {
+				continue
+			}
+			if breakers[req] {
+				warnings = append(warnings, fmt.Sprintf("cycle-breakers: dropped edge %s -> %s", path, req))
+				continue
+			}
+			depGraph[path] = append(depGraph[path], req)
+			edges = append(edges, ReleasePlanEdge{From: path, To: req})
+		}
+	}
+
+	order, cycle, ok := topoSortModules(depGraph, nodes)
+	if !ok {
+		return nil, fmt.Errorf("dependency cycle detected: %s", strings.Join(cycle, " -> "))
+	}
+
+	entries := make([]ReleasePlanEntry, 0, len(infos))
+	for _, path := range order {
+		entries = append(entries, op.buildEntry(ctx, byPath[path], bumpType))
+	}
+	for _, info := range infos {
+		if info.modulePath == "" {
+			entries = append(entries, op.buildEntry(ctx, info, bumpType))
+		}
+	}
+
+	return &ReleasePlan{Modules: entries, Edges: edges, Warnings: warnings}, nil
+}
+
+// buildEntry previews a single module's plan entry: its current version and,
+// when bumpType supports a deterministic preview, its planned next version.
+func (op *ReleasePlanOperation) buildEntry(ctx context.Context, info *moduleInfo, bumpType BumpType) ReleasePlanEntry {
+	entry := ReleasePlanEntry{ModulePath: info.modulePath, Dir: info.mod.Dir}
+
+	vm := semver.NewVersionManager(op.fs, nil)
+	currentVer, err := vm.Read(ctx, info.mod.Path)
+	if err != nil {
+		entry.SkipReason = fmt.Sprintf("failed to read version: %v", err)
+		return entry
+	}
+	entry.CurrentVersion = currentVer.String()
+
+	if bumpType == BumpAuto {
+		entry.SkipReason = "auto bump requires commit history analysis; choose an explicit bump type to preview a plan"
+		return entry
+	}
+
+	newVer, err := computeBump(bumpType, currentVer)
+	if err != nil {
+		entry.SkipReason = err.Error()
+		return entry
+	}
+	entry.NewVersion = newVer.String()
+	return entry
+}
+
+// Apply executes a previously built plan in order: bumps each module's
+// .version file, rewrites downstream go.mod "require" lines that reference a
+// just-released sibling to its new version, and invokes
+// opts.OnModuleReleased (if set) after each module so the caller can create
+// and push its tag. Entries with a SkipReason, or without a NewVersion, are
+// left untouched.
+func (op *ReleasePlanOperation) Apply(ctx context.Context, plan *ReleasePlan, opts ApplyOptions) error {
+	vm := semver.NewVersionManager(op.fs, nil)
+
+	for _, entry := range plan.Modules {
+		if entry.SkipReason != "" || entry.NewVersion == "" {
+			continue
+		}
+
+		newVer, err := semver.ParseVersion(entry.NewVersion)
+		if err != nil {
+			return fmt.Errorf("invalid planned version %q for %s: %w", entry.NewVersion, entry.ModulePath, err)
+		}
+
+		versionPath := filepath.Join(entry.Dir, ".version")
+		if err := vm.Save(ctx, versionPath, newVer); err != nil {
+			return fmt.Errorf("failed to write version for %s: %w", entry.ModulePath, err)
+		}
+
+		if err := op.updateDownstreamRequires(ctx, plan.Modules, entry.ModulePath, entry.NewVersion); err != nil {
+			return fmt.Errorf("failed to update downstream requires for %s: %w", entry.ModulePath, err)
+		}
+
+		if opts.OnModuleReleased != nil {
+			if err := opts.OnModuleReleased(entry); err != nil {
+				return fmt.Errorf("release hook failed for %s: %w", entry.ModulePath, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// updateDownstreamRequires rewrites the "require" line for releasedPath in
+// every other module's go.mod to pin newVersion, so downstream modules build
+// against the freshly released sibling.
+func (op *ReleasePlanOperation) updateDownstreamRequires(ctx context.Context, entries []ReleasePlanEntry, releasedPath, newVersion string) error {
+	for _, entry := range entries {
+		if entry.ModulePath == releasedPath {
+			continue
+		}
+
+		goModPath := filepath.Join(entry.Dir, "go.mod")
+		data, err := op.fs.ReadFile(ctx, goModPath)
+		if err != nil {
+			continue
+		}
+
+		updated, changed := bumpRequireVersion(string(data), releasedPath, newVersion)
+		if !changed {
+			continue
+		}
+
+		if err := op.fs.WriteFile(ctx, goModPath, []byte(updated), 0644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", goModPath, err)
+		}
+	}
+
+	return nil
+}
+
+// bumpRequireVersion replaces the version on any "require" line (inside or
+// outside a "require (...)" block) referencing modulePath with newVersion.
+func bumpRequireVersion(content, modulePath, newVersion string) (string, bool) {
+	lines := strings.Split(content, "\n")
+	changed := false
+
+	for i, line := range lines {
+		fields := strings.Fields(line)
+		for j, field := range fields {
+			if field != modulePath {
+				continue
+			}
+			if j+1 >= len(fields) || !strings.HasPrefix(fields[j+1], "v") {
+				continue
+			}
+			fields[j+1] = "v" + newVersion
+			lines[i] = rebuildLine(line, fields)
+			changed = true
+		}
+	}
+
+	if !changed {
+		return content, false
+	}
+	return strings.Join(lines, "\n"), true
+}
+
+// rebuildLine replaces the content of a go.mod line with fields, preserving
+// leading whitespace/indentation from the original line.
+func rebuildLine(original string, fields []string) string {
+	indent := original[:len(original)-len(strings.TrimLeft(original, " \t"))]
+	return indent + strings.Join(fields, " ")
+}
+
+// parseGoMod extracts a go.mod's module path and the module paths listed in
+// its "require" directives (both the grouped "require (...)" form and
+// single-line "require module vX.Y.Z" directives). Only module paths are
+// returned; versions and "// indirect" annotations are discarded since only
+// sibling ordering matters to the release planner.
+func parseGoMod(data []byte) (modulePath string, requires []string) {
+	inRequireBlock := false
+
+	for _, raw := range strings.Split(string(data), "\n") {
+		line := strings.TrimSpace(raw)
+		if idx := strings.Index(line, "//"); idx >= 0 {
+			line = strings.TrimSpace(line[:idx])
+		}
+		if line == "" {
+			continue
+		}
+
+		switch {
+		case modulePath == "" && strings.HasPrefix(line, "module "):
+			modulePath = strings.TrimSpace(strings.TrimPrefix(line, "module "))
+		case strings.HasPrefix(line, "require ("):
+			inRequireBlock = true
+		case inRequireBlock && line == ")":
+			inRequireBlock = false
+		case inRequireBlock:
+			if fields := strings.Fields(line); len(fields) >= 1 {
+				requires = append(requires, fields[0])
+			}
+		case strings.HasPrefix(line, "require "):
+			if fields := strings.Fields(strings.TrimPrefix(line, "require ")); len(fields) >= 1 {
+				requires = append(requires, fields[0])
+			}
+		}
+	}
+
+	return modulePath, requires
+}
+
+// topoSortModules performs a depth-first post-order traversal of graph
+// (module path -> its sibling dependencies) so that every module appears
+// after the siblings it depends on. nodes is the full set of module paths to
+// order, including ones with no outgoing edges. ok is false if a cycle is
+// found, in which case cycle holds the offending path.
+func topoSortModules(graph map[string][]string, nodes []string) (order []string, cycle []string, ok bool) {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(nodes))
+	var path []string
+
+	var visit func(n string) bool
+	visit = func(n string) bool {
+		switch state[n] {
+		case visited:
+			return true
+		case visiting:
+			start := indexOfString(path, n)
+			cycle = append(append([]string{}, path[start:]...), n)
+			return false
+		}
+
+		state[n] = visiting
+		path = append(path, n)
+		for _, dep := range graph[n] {
+			if !visit(dep) {
+				return false
+			}
+		}
+		path = path[:len(path)-1]
+		state[n] = visited
+		order = append(order, n)
+		return true
+	}
+
+	for _, n := range nodes {
+		if state[n] == unvisited {
+			if !visit(n) {
+				return nil, cycle, false
+			}
+		}
+	}
+
+	return order, nil, true
+}
+
+// indexOfString returns the index of s in slice, or -1 if not present.
+func indexOfString(slice []string, s string) int {
+	for i, v := range slice {
+		if v == s {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,281 @@
+package operations
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/indaco/sley/internal/core"
+	"github.com/indaco/sley/internal/workspace"
+)
+
+func setupReleasePlanFS(fs *core.MockFileSystem) []*workspace.Module {
+	fs.SetFile("/ws/core/go.mod", []byte("module example.com/ws/core\n\ngo 1.21\n"))
+	fs.SetFile("/ws/core/.version", []byte("1.0.0\n"))
+
+	fs.SetFile("/ws/api/go.mod", []byte(`module example.com/ws/api
+
+go 1.21
+
+require (
+	example.com/ws/core v1.0.0
+)
+`))
+	fs.SetFile("/ws/api/.version", []byte("2.0.0\n"))
+
+	fs.SetFile("/ws/cli/go.mod", []byte("module example.com/ws/cli\n\ngo 1.21\n\nrequire example.com/ws/api v2.0.0\n"))
+	fs.SetFile("/ws/cli/.version", []byte("0.5.0\n"))
+
+	return []*workspace.Module{
+		{Name: "core", Dir: "/ws/core", Path: "/ws/core/.version"},
+		{Name: "api", Dir: "/ws/api", Path: "/ws/api/.version"},
+		{Name: "cli", Dir: "/ws/cli", Path: "/ws/cli/.version"},
+	}
+}
+
+func TestParseGoMod(t *testing.T) {
+	t.Run("grouped require block", func(t *testing.T) {
+		modulePath, requires := parseGoMod([]byte(`module example.com/foo
+
+go 1.21
+
+require (
+	example.com/bar v1.2.3
+	example.com/baz v0.1.0 // indirect
+)
+`))
+		if modulePath != "example.com/foo" {
+			t.Errorf("modulePath = %q, want %q", modulePath, "example.com/foo")
+		}
+		if len(requires) != 2 || requires[0] != "example.com/bar" || requires[1] != "example.com/baz" {
+			t.Errorf("requires = %v", requires)
+		}
+	})
+
+	t.Run("single-line require", func(t *testing.T) {
+		modulePath, requires := parseGoMod([]byte("module example.com/foo\n\nrequire example.com/bar v1.2.3\n"))
+		if modulePath != "example.com/foo" {
+			t.Errorf("modulePath = %q, want %q", modulePath, "example.com/foo")
+		}
+		if len(requires) != 1 || requires[0] != "example.com/bar" {
+			t.Errorf("requires = %v", requires)
+		}
+	})
+
+	t.Run("no requires", func(t *testing.T) {
+		modulePath, requires := parseGoMod([]byte("module example.com/foo\n\ngo 1.21\n"))
+		if modulePath != "example.com/foo" {
+			t.Errorf("modulePath = %q, want %q", modulePath, "example.com/foo")
+		}
+		if len(requires) != 0 {
+			t.Errorf("requires = %v, want none", requires)
+		}
+	})
+}
+
+func TestReleasePlanOperation_BuildPlan_OrdersByDependency(t *testing.T) {
+	fs := core.NewMockFileSystem()
+	modules := setupReleasePlanFS(fs)
+
+	op := NewReleasePlanOperation(fs)
+	plan, err := op.BuildPlan(context.Background(), modules, BumpMinor, nil)
+	if err != nil {
+		t.Fatalf("BuildPlan() error = %v", err)
+	}
+
+	if len(plan.Modules) != 3 {
+		t.Fatalf("expected 3 planned modules, got %d", len(plan.Modules))
+	}
+
+	order := make(map[string]int, len(plan.Modules))
+	for i, entry := range plan.Modules {
+		order[entry.ModulePath] = i
+	}
+
+	if order["example.com/ws/core"] >= order["example.com/ws/api"] {
+		t.Errorf("expected core to be ordered before api, got order: %+v", order)
+	}
+	if order["example.com/ws/api"] >= order["example.com/ws/cli"] {
+		t.Errorf("expected api to be ordered before cli, got order: %+v", order)
+	}
+
+	for _, entry := range plan.Modules {
+		if entry.SkipReason != "" {
+			t.Errorf("unexpected skip reason for %s: %s", entry.ModulePath, entry.SkipReason)
+		}
+	}
+
+	coreEntry := plan.Modules[order["example.com/ws/core"]]
+	if coreEntry.CurrentVersion != "1.0.0" || coreEntry.NewVersion != "1.1.0" {
+		t.Errorf("core entry = %+v, want current 1.0.0 / new 1.1.0", coreEntry)
+	}
+}
+
+func TestReleasePlanOperation_BuildPlan_DetectsCycle(t *testing.T) {
+	fs := core.NewMockFileSystem()
+	fs.SetFile("/ws/a/go.mod", []byte("module example.com/ws/a\n\nrequire example.com/ws/b v1.0.0\n"))
+	fs.SetFile("/ws/a/.version", []byte("1.0.0\n"))
+	fs.SetFile("/ws/b/go.mod", []byte("module example.com/ws/b\n\nrequire example.com/ws/a v1.0.0\n"))
+	fs.SetFile("/ws/b/.version", []byte("1.0.0\n"))
+
+	modules := []*workspace.Module{
+		{Name: "a", Dir: "/ws/a", Path: "/ws/a/.version"},
+		{Name: "b", Dir: "/ws/b", Path: "/ws/b/.version"},
+	}
+
+	op := NewReleasePlanOperation(fs)
+	_, err := op.BuildPlan(context.Background(), modules, BumpPatch, nil)
+	if err == nil {
+		t.Fatal("expected cycle detection error, got nil")
+	}
+}
+
+func TestReleasePlanOperation_BuildPlan_CycleBreakersDropEdge(t *testing.T) {
+	fs := core.NewMockFileSystem()
+	fs.SetFile("/ws/a/go.mod", []byte("module example.com/ws/a\n\nrequire example.com/ws/b v1.0.0\n"))
+	fs.SetFile("/ws/a/.version", []byte("1.0.0\n"))
+	fs.SetFile("/ws/b/go.mod", []byte("module example.com/ws/b\n\nrequire example.com/ws/a v1.0.0\n"))
+	fs.SetFile("/ws/b/.version", []byte("1.0.0\n"))
+
+	modules := []*workspace.Module{
+		{Name: "a", Dir: "/ws/a", Path: "/ws/a/.version"},
+		{Name: "b", Dir: "/ws/b", Path: "/ws/b/.version"},
+	}
+
+	op := NewReleasePlanOperation(fs)
+	plan, err := op.BuildPlan(context.Background(), modules, BumpPatch, []string{"example.com/ws/a"})
+	if err != nil {
+		t.Fatalf("BuildPlan() error = %v", err)
+	}
+	if len(plan.Warnings) != 1 {
+		t.Fatalf("expected 1 warning, got %d: %v", len(plan.Warnings), plan.Warnings)
+	}
+	if len(plan.Edges) != 1 {
+		t.Errorf("expected only 1 surviving edge, got %d: %+v", len(plan.Edges), plan.Edges)
+	}
+}
+
+func TestReleasePlanOperation_BuildPlan_AutoBumpSkipped(t *testing.T) {
+	fs := core.NewMockFileSystem()
+	modules := setupReleasePlanFS(fs)
+
+	op := NewReleasePlanOperation(fs)
+	plan, err := op.BuildPlan(context.Background(), modules, BumpAuto, nil)
+	if err != nil {
+		t.Fatalf("BuildPlan() error = %v", err)
+	}
+	for _, entry := range plan.Modules {
+		if entry.SkipReason == "" {
+			t.Errorf("expected auto bump to be skipped for %s", entry.ModulePath)
+		}
+	}
+}
+
+func TestReleasePlanOperation_BuildPlan_ModuleWithoutGoMod(t *testing.T) {
+	fs := core.NewMockFileSystem()
+	fs.SetFile("/ws/standalone/.version", []byte("1.0.0\n"))
+
+	modules := []*workspace.Module{
+		{Name: "standalone", Dir: "/ws/standalone", Path: "/ws/standalone/.version"},
+	}
+
+	op := NewReleasePlanOperation(fs)
+	plan, err := op.BuildPlan(context.Background(), modules, BumpPatch, nil)
+	if err != nil {
+		t.Fatalf("BuildPlan() error = %v", err)
+	}
+	if len(plan.Modules) != 1 {
+		t.Fatalf("expected 1 module, got %d", len(plan.Modules))
+	}
+	if plan.Modules[0].NewVersion != "1.0.1" {
+		t.Errorf("NewVersion = %q, want %q", plan.Modules[0].NewVersion, "1.0.1")
+	}
+}
+
+func TestReleasePlanOperation_Apply_BumpsAndRewritesDownstreamRequires(t *testing.T) {
+	fs := core.NewMockFileSystem()
+	modules := setupReleasePlanFS(fs)
+
+	op := NewReleasePlanOperation(fs)
+	plan, err := op.BuildPlan(context.Background(), modules, BumpMinor, nil)
+	if err != nil {
+		t.Fatalf("BuildPlan() error = %v", err)
+	}
+
+	var released []string
+	err = op.Apply(context.Background(), plan, ApplyOptions{
+		OnModuleReleased: func(entry ReleasePlanEntry) error {
+			released = append(released, entry.ModulePath+"@"+entry.NewVersion)
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+
+	if len(released) != 3 {
+		t.Fatalf("expected 3 release hook calls, got %d: %v", len(released), released)
+	}
+
+	coreVersion, _ := fs.GetFile("/ws/core/.version")
+	if string(coreVersion) != "1.1.0\n" {
+		t.Errorf("core .version = %q, want %q", coreVersion, "1.1.0\n")
+	}
+
+	apiGoMod, _ := fs.GetFile("/ws/api/go.mod")
+	if !strings.Contains(string(apiGoMod), "example.com/ws/core v1.1.0") {
+		t.Errorf("expected api/go.mod to require the bumped core version, got:\n%s", apiGoMod)
+	}
+
+	cliGoMod, _ := fs.GetFile("/ws/cli/go.mod")
+	if !strings.Contains(string(cliGoMod), "example.com/ws/api v2.1.0") {
+		t.Errorf("expected cli/go.mod to require the bumped api version, got:\n%s", cliGoMod)
+	}
+}
+
+func TestReleasePlanOperation_Apply_SkipsEntriesWithSkipReason(t *testing.T) {
+	fs := core.NewMockFileSystem()
+	modules := setupReleasePlanFS(fs)
+
+	op := NewReleasePlanOperation(fs)
+	plan, err := op.BuildPlan(context.Background(), modules, BumpAuto, nil)
+	if err != nil {
+		t.Fatalf("BuildPlan() error = %v", err)
+	}
+
+	called := false
+	err = op.Apply(context.Background(), plan, ApplyOptions{
+		OnModuleReleased: func(entry ReleasePlanEntry) error {
+			called = true
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+	if called {
+		t.Error("expected no release hook calls for a fully-skipped plan")
+	}
+}
+
+func TestReleasePlanOperation_Apply_PropagatesReleaseHookError(t *testing.T) {
+	fs := core.NewMockFileSystem()
+	modules := setupReleasePlanFS(fs)
+
+	op := NewReleasePlanOperation(fs)
+	plan, err := op.BuildPlan(context.Background(), modules, BumpPatch, nil)
+	if err != nil {
+		t.Fatalf("BuildPlan() error = %v", err)
+	}
+
+	wantErr := errors.New("tag push failed")
+	err = op.Apply(context.Background(), plan, ApplyOptions{
+		OnModuleReleased: func(entry ReleasePlanEntry) error {
+			return wantErr
+		},
+	})
+	if err == nil {
+		t.Fatal("expected Apply() to propagate release hook error")
+	}
+}
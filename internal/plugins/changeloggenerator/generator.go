@@ -204,6 +204,35 @@ func (g *Generator) writeContributorEntry(sb *strings.Builder, contrib Contribut
 	sb.WriteString("\n")
 }
 
+// GenerateReleaseNotes renders a standalone release-notes document for
+// version using format: template. It returns an error for any other format,
+// since release notes rely on the SectionTypes-driven template model.
+func (g *Generator) GenerateReleaseNotes(version, previousVersion string, commits []CommitInfo) (string, error) {
+	tf, ok := g.formatter.(*TemplateFormatter)
+	if !ok {
+		return "", fmt.Errorf("release notes require format: template (configured format: %s)", g.config.Format)
+	}
+
+	parsed := ParseCommits(commits)
+	filtered := FilterCommits(parsed, g.config.ExcludePatterns)
+	groupResult := GroupCommitsWithOptions(filtered, g.config.Groups, g.config.IncludeNonConventional)
+
+	remote, _ := g.resolveRemote() // Ignore error, just won't have links
+
+	sortedKeys := SortedGroupKeys(groupResult.Grouped)
+	return tf.FormatReleaseNotes(version, previousVersion, groupResult.Grouped, sortedKeys, remote)
+}
+
+// WriteReleaseNotesFile writes standalone release notes content to path,
+// overwriting any existing file (unlike the changelog, which accumulates).
+func (g *Generator) WriteReleaseNotesFile(path, content string) error {
+	normalized := strings.TrimRight(content, "\n\r\t ") + "\n"
+	if err := os.WriteFile(path, []byte(normalized), 0644); err != nil {
+		return fmt.Errorf("failed to write release notes: %w", err)
+	}
+	return nil
+}
+
 // WriteVersionedFile writes the changelog to a version-specific file.
 func (g *Generator) WriteVersionedFile(version, content string) error {
 	dir := g.config.ChangesDir
@@ -0,0 +1,89 @@
+package changeloggenerator
+
+// Section-type identifiers for the "section-types"/"commit-types" model
+// (the git-sv configuration model) used by format: template.
+const (
+	SectionTypeCommits         = "commits"
+	SectionTypeBreakingChanges = "breaking-changes"
+)
+
+// SectionConfig defines one named changelog section and how commits are
+// routed into it. SectionType "commits" routes commits whose type appears
+// in CommitTypes; SectionType "breaking-changes" routes any commit carrying
+// a breaking-change marker, regardless of type.
+type SectionConfig struct {
+	Name        string
+	SectionType string
+	CommitTypes []string
+}
+
+// DefaultSectionTypes returns the default section layout used by
+// format: template: Breaking Changes, Features, Bug Fixes, and Performance.
+func DefaultSectionTypes() []SectionConfig {
+	return []SectionConfig{
+		{Name: "Breaking Changes", SectionType: SectionTypeBreakingChanges},
+		{Name: "Features", SectionType: SectionTypeCommits, CommitTypes: []string{"feat"}},
+		{Name: "Bug Fixes", SectionType: SectionTypeCommits, CommitTypes: []string{"fix"}},
+		{Name: "Performance", SectionType: SectionTypeCommits, CommitTypes: []string{"perf"}},
+	}
+}
+
+// classifyIntoSections buckets commits into sections, in section order.
+// When a breaking-changes section is configured, a breaking commit is routed
+// to it only, never to a commit-types section, mirroring how GroupedFormatter
+// separates breaking changes from the regular grouped sections. Without one,
+// a breaking commit is routed by its type like any other commit.
+func classifyIntoSections(commits []*GroupedCommit, sections []SectionConfig) []templateSection {
+	result := make([]templateSection, len(sections))
+	for i, s := range sections {
+		result[i] = templateSection{Name: s.Name}
+	}
+
+	typeIndex := make(map[string]int)
+	breakingIdx := -1
+	for i, s := range sections {
+		if s.SectionType == SectionTypeBreakingChanges {
+			if breakingIdx == -1 {
+				breakingIdx = i
+			}
+			continue
+		}
+		for _, t := range s.CommitTypes {
+			typeIndex[t] = i
+		}
+	}
+
+	for _, c := range commits {
+		if c.Breaking && breakingIdx != -1 {
+			result[breakingIdx].Commits = append(result[breakingIdx].Commits, c)
+			continue
+		}
+		if idx, ok := typeIndex[c.Type]; ok {
+			result[idx].Commits = append(result[idx].Commits, c)
+		}
+	}
+
+	return result
+}
+
+// sectionTypesOrDefault returns sections, falling back to DefaultSectionTypes
+// when none are configured.
+func sectionTypesOrDefault(sections []SectionConfig) []SectionConfig {
+	if len(sections) > 0 {
+		return sections
+	}
+	return DefaultSectionTypes()
+}
+
+// flattenGrouped collects every commit across a grouped map back into a
+// single slice, discarding the group labels, in sortedKeys order so the
+// result is deterministic regardless of map iteration order. Used by
+// TemplateFormatter to reclassify commits by SectionConfig instead of by
+// regex Groups.
+func flattenGrouped(grouped map[string][]*GroupedCommit, sortedKeys []string) []*GroupedCommit {
+	var all []*GroupedCommit
+	for _, key := range sortedKeys {
+		all = append(all, grouped[key]...)
+	}
+	return all
+}
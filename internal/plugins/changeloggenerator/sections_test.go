@@ -0,0 +1,80 @@
+package changeloggenerator
+
+import "testing"
+
+func commit(shortHash, commitType string, breaking bool) *GroupedCommit {
+	return &GroupedCommit{
+		ParsedCommit: &ParsedCommit{
+			CommitInfo: CommitInfo{ShortHash: shortHash},
+			Type:       commitType,
+			Breaking:   breaking,
+		},
+	}
+}
+
+func TestDefaultSectionTypes(t *testing.T) {
+	sections := DefaultSectionTypes()
+
+	if len(sections) != 4 {
+		t.Fatalf("expected 4 default sections, got %d", len(sections))
+	}
+	if sections[0].Name != "Breaking Changes" || sections[0].SectionType != SectionTypeBreakingChanges {
+		t.Errorf("expected first section to be breaking-changes, got %+v", sections[0])
+	}
+}
+
+func TestSectionTypesOrDefault(t *testing.T) {
+	if got := sectionTypesOrDefault(nil); len(got) != len(DefaultSectionTypes()) {
+		t.Errorf("expected default sections when none configured, got %d", len(got))
+	}
+
+	custom := []SectionConfig{{Name: "Custom", SectionType: SectionTypeCommits, CommitTypes: []string{"feat"}}}
+	if got := sectionTypesOrDefault(custom); len(got) != 1 {
+		t.Errorf("expected configured sections to be preserved, got %d", len(got))
+	}
+}
+
+func TestClassifyIntoSections(t *testing.T) {
+	sections := DefaultSectionTypes()
+	commits := []*GroupedCommit{
+		commit("aaa", "feat", false),
+		commit("bbb", "fix", false),
+		commit("ccc", "feat", true), // breaking feat goes to Breaking Changes, not Features
+		commit("ddd", "docs", false), // unclassified type, dropped
+	}
+
+	result := classifyIntoSections(commits, sections)
+
+	byName := make(map[string][]*GroupedCommit)
+	for _, s := range result {
+		byName[s.Name] = s.Commits
+	}
+
+	if len(byName["Breaking Changes"]) != 1 || byName["Breaking Changes"][0].ShortHash != "ccc" {
+		t.Errorf("expected breaking commit routed to Breaking Changes, got %+v", byName["Breaking Changes"])
+	}
+	if len(byName["Features"]) != 1 || byName["Features"][0].ShortHash != "aaa" {
+		t.Errorf("expected non-breaking feat routed to Features, got %+v", byName["Features"])
+	}
+	if len(byName["Bug Fixes"]) != 1 || byName["Bug Fixes"][0].ShortHash != "bbb" {
+		t.Errorf("expected fix routed to Bug Fixes, got %+v", byName["Bug Fixes"])
+	}
+	if len(byName["Performance"]) != 0 {
+		t.Errorf("expected no Performance commits, got %+v", byName["Performance"])
+	}
+}
+
+func TestFlattenGrouped(t *testing.T) {
+	grouped := map[string][]*GroupedCommit{
+		"A": {commit("aaa", "feat", false)},
+		"B": {commit("bbb", "fix", false), commit("ccc", "fix", false)},
+	}
+
+	flat := flattenGrouped(grouped, []string{"A", "B"})
+	if len(flat) != 3 {
+		t.Errorf("expected 3 flattened commits, got %d", len(flat))
+	}
+	if flat[0].ShortHash != "aaa" || flat[1].ShortHash != "bbb" || flat[2].ShortHash != "ccc" {
+		t.Errorf("expected flattening in sortedKeys order, got %+v", flat)
+	}
+}
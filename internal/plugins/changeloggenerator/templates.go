@@ -0,0 +1,161 @@
+package changeloggenerator
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/template"
+	"time"
+)
+
+//go:embed templates/changelog-md.tpl templates/releasenotes-md.tpl
+var defaultTemplatesFS embed.FS
+
+const (
+	defaultChangelogTemplatePath    = "templates/changelog-md.tpl"
+	defaultReleaseNotesTemplatePath = "templates/releasenotes-md.tpl"
+)
+
+// templateSection is one section's commits as exposed to changelog and
+// release-notes templates.
+type templateSection struct {
+	Name    string
+	Commits []*GroupedCommit
+}
+
+// templateData is the root object exposed to changelog/release-notes
+// templates.
+type templateData struct {
+	Version         string
+	PreviousVersion string
+	Date            time.Time
+	Sections        []templateSection
+	Remote          *RemoteInfo
+}
+
+// TemplateFormatter renders changelogs and release notes with a Go
+// text/template, classifying commits into named sections per the config's
+// SectionTypes (the git-sv "section-types"/"commit-types" model) instead of
+// the regex-based Groups used by the other formatters.
+type TemplateFormatter struct {
+	config *Config
+}
+
+// FormatChangelog renders the changelog entry for version using the
+// configured (or embedded default) changelog template.
+func (f *TemplateFormatter) FormatChangelog(
+	version string,
+	previousVersion string,
+	grouped map[string][]*GroupedCommit,
+	sortedKeys []string,
+	remote *RemoteInfo,
+) string {
+	tpl, err := f.loadTemplate(f.config.Template, defaultChangelogTemplatePath)
+	if err != nil {
+		return fmt.Sprintf("<!-- changelog template error: %s -->\n", err)
+	}
+	return renderTemplate(tpl, f.buildData(version, previousVersion, grouped, sortedKeys, remote))
+}
+
+// FormatReleaseNotes renders a standalone release-notes document for
+// version, suitable as a GitHub/Gitea release body.
+func (f *TemplateFormatter) FormatReleaseNotes(
+	version string,
+	previousVersion string,
+	grouped map[string][]*GroupedCommit,
+	sortedKeys []string,
+	remote *RemoteInfo,
+) (string, error) {
+	tpl, err := f.loadTemplate(f.config.ReleaseNotesTemplate, defaultReleaseNotesTemplatePath)
+	if err != nil {
+		return "", err
+	}
+	return renderTemplate(tpl, f.buildData(version, previousVersion, grouped, sortedKeys, remote)), nil
+}
+
+// buildData classifies the flattened commits (flattened in sortedKeys order,
+// so section contents are deterministic across runs) into the configured
+// sections and assembles the template root object.
+func (f *TemplateFormatter) buildData(
+	version, previousVersion string,
+	grouped map[string][]*GroupedCommit,
+	sortedKeys []string,
+	remote *RemoteInfo,
+) templateData {
+	sections := sectionTypesOrDefault(f.config.SectionTypes)
+	return templateData{
+		Version:         version,
+		PreviousVersion: previousVersion,
+		Date:            time.Now(),
+		Sections:        classifyIntoSections(flattenGrouped(grouped, sortedKeys), sections),
+		Remote:          remote,
+	}
+}
+
+// loadTemplate reads overridePath if set, otherwise the embedded default at
+// embeddedPath, and parses it with the template helper funcs installed.
+func (f *TemplateFormatter) loadTemplate(overridePath, embeddedPath string) (*template.Template, error) {
+	var raw []byte
+	var err error
+
+	if overridePath != "" {
+		raw, err = os.ReadFile(overridePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read template %s: %w", overridePath, err)
+		}
+	} else {
+		raw, err = defaultTemplatesFS.ReadFile(embeddedPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read embedded template %s: %w", embeddedPath, err)
+		}
+	}
+
+	tpl, err := template.New(filepath.Base(embeddedPath)).Funcs(templateFuncMap()).Parse(string(raw))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template: %w", err)
+	}
+	return tpl, nil
+}
+
+// renderTemplate executes tpl against data, returning an HTML-comment
+// placeholder on execution failure so a broken custom template doesn't
+// abort the whole bump.
+func renderTemplate(tpl *template.Template, data templateData) string {
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, data); err != nil {
+		return fmt.Sprintf("<!-- template execution error: %s -->\n", err)
+	}
+	return buf.String()
+}
+
+// templateFuncMap returns the helper funcs available to changelog and
+// release-notes templates: timefmt, getsection, and commitURL.
+func templateFuncMap() template.FuncMap {
+	return template.FuncMap{
+		"timefmt":    func(t time.Time, layout string) string { return t.Format(layout) },
+		"getsection": getSectionByName,
+		"commitURL":  commitURLForTemplate,
+	}
+}
+
+// getSectionByName returns the commits for the named section, or nil if no
+// section with that name was classified.
+func getSectionByName(sections []templateSection, name string) []*GroupedCommit {
+	for _, s := range sections {
+		if s.Name == name {
+			return s.Commits
+		}
+	}
+	return nil
+}
+
+// commitURLForTemplate builds a commit link for the configured remote,
+// returning "" when no remote is available (e.g. auto-detect failed).
+func commitURLForTemplate(remote *RemoteInfo, hash string) string {
+	if remote == nil {
+		return ""
+	}
+	return buildCommitURL(remote, hash)
+}
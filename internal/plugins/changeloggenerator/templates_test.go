@@ -0,0 +1,197 @@
+package changeloggenerator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func templateGroupedCommits() map[string][]*GroupedCommit {
+	return map[string][]*GroupedCommit{
+		"Enhancements": {
+			{
+				ParsedCommit: &ParsedCommit{
+					CommitInfo:  CommitInfo{ShortHash: "abc123"},
+					Type:        "feat",
+					Scope:       "api",
+					Description: "add export endpoint",
+				},
+			},
+		},
+		"Fixes": {
+			{
+				ParsedCommit: &ParsedCommit{
+					CommitInfo:  CommitInfo{ShortHash: "def456"},
+					Type:        "fix",
+					Description: "resolve crash on startup",
+				},
+			},
+			{
+				ParsedCommit: &ParsedCommit{
+					CommitInfo:  CommitInfo{ShortHash: "ghi789"},
+					Type:        "fix",
+					Description: "remove legacy flag",
+					Breaking:    true,
+				},
+			},
+		},
+	}
+}
+
+func TestTemplateFormatter_FormatChangelog_DefaultTemplate(t *testing.T) {
+	cfg := DefaultConfig()
+	formatter := &TemplateFormatter{config: cfg}
+
+	remote := &RemoteInfo{Provider: "github", Host: "github.com", Owner: "acme", Repo: "widgets"}
+
+	grouped := templateGroupedCommits()
+	result := formatter.FormatChangelog("v1.1.0", "v1.0.0", grouped, SortedGroupKeys(grouped), remote)
+
+	if !strings.Contains(result, "## v1.1.0") {
+		t.Errorf("expected version header, got:\n%s", result)
+	}
+	if !strings.Contains(result, "### Breaking Changes") {
+		t.Errorf("expected Breaking Changes section, got:\n%s", result)
+	}
+	if !strings.Contains(result, "remove legacy flag") {
+		t.Errorf("expected breaking commit description, got:\n%s", result)
+	}
+	if !strings.Contains(result, "### Features") {
+		t.Errorf("expected Features section, got:\n%s", result)
+	}
+	if !strings.Contains(result, "**api:** add export endpoint") {
+		t.Errorf("expected scoped feature entry, got:\n%s", result)
+	}
+	if !strings.Contains(result, "https://github.com/acme/widgets/commit/abc123") {
+		t.Errorf("expected commitURL link, got:\n%s", result)
+	}
+}
+
+func TestTemplateFormatter_FormatChangelog_NoRemote(t *testing.T) {
+	cfg := DefaultConfig()
+	formatter := &TemplateFormatter{config: cfg}
+
+	grouped := templateGroupedCommits()
+	result := formatter.FormatChangelog("v1.1.0", "v1.0.0", grouped, SortedGroupKeys(grouped), nil)
+
+	if strings.Contains(result, "](https://") {
+		t.Errorf("expected no commit links without a remote, got:\n%s", result)
+	}
+	if !strings.Contains(result, "add export endpoint") {
+		t.Errorf("expected feature description present, got:\n%s", result)
+	}
+}
+
+func TestTemplateFormatter_FormatChangelog_CustomSectionTypes(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.SectionTypes = []SectionConfig{
+		{Name: "New Stuff", SectionType: SectionTypeCommits, CommitTypes: []string{"feat"}},
+	}
+	formatter := &TemplateFormatter{config: cfg}
+
+	grouped := templateGroupedCommits()
+	result := formatter.FormatChangelog("v1.1.0", "v1.0.0", grouped, SortedGroupKeys(grouped), nil)
+
+	if !strings.Contains(result, "### New Stuff") {
+		t.Errorf("expected custom section name, got:\n%s", result)
+	}
+	if strings.Contains(result, "### Bug Fixes") {
+		t.Errorf("expected fix commits to be dropped when no matching section, got:\n%s", result)
+	}
+}
+
+func TestTemplateFormatter_FormatChangelog_CustomTemplateOverride(t *testing.T) {
+	dir := t.TempDir()
+	tplPath := filepath.Join(dir, "custom.tpl")
+	if err := os.WriteFile(tplPath, []byte("CUSTOM: {{ .Version }}\n"), 0644); err != nil {
+		t.Fatalf("failed to write custom template: %v", err)
+	}
+
+	cfg := DefaultConfig()
+	cfg.Template = tplPath
+	formatter := &TemplateFormatter{config: cfg}
+
+	result := formatter.FormatChangelog("v2.0.0", "v1.0.0", templateGroupedCommits(), nil, nil)
+
+	if result != "CUSTOM: v2.0.0\n" {
+		t.Errorf("expected custom template output, got %q", result)
+	}
+}
+
+func TestTemplateFormatter_FormatChangelog_MissingCustomTemplate(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Template = filepath.Join(t.TempDir(), "does-not-exist.tpl")
+	formatter := &TemplateFormatter{config: cfg}
+
+	result := formatter.FormatChangelog("v2.0.0", "v1.0.0", templateGroupedCommits(), nil, nil)
+
+	if !strings.Contains(result, "template error") {
+		t.Errorf("expected template error placeholder, got %q", result)
+	}
+}
+
+func TestTemplateFormatter_FormatChangelog_InvalidCustomTemplate(t *testing.T) {
+	dir := t.TempDir()
+	tplPath := filepath.Join(dir, "broken.tpl")
+	if err := os.WriteFile(tplPath, []byte("{{ .Version "), 0644); err != nil {
+		t.Fatalf("failed to write broken template: %v", err)
+	}
+
+	cfg := DefaultConfig()
+	cfg.Template = tplPath
+	formatter := &TemplateFormatter{config: cfg}
+
+	result := formatter.FormatChangelog("v2.0.0", "v1.0.0", templateGroupedCommits(), nil, nil)
+
+	if !strings.Contains(result, "template error") {
+		t.Errorf("expected template error placeholder, got %q", result)
+	}
+}
+
+func TestTemplateFormatter_FormatReleaseNotes(t *testing.T) {
+	cfg := DefaultConfig()
+	formatter := &TemplateFormatter{config: cfg}
+	remote := &RemoteInfo{Provider: "github", Host: "github.com", Owner: "acme", Repo: "widgets"}
+
+	grouped := templateGroupedCommits()
+	notes, err := formatter.FormatReleaseNotes("v1.1.0", "v1.0.0", grouped, SortedGroupKeys(grouped), remote)
+	if err != nil {
+		t.Fatalf("FormatReleaseNotes() error = %v", err)
+	}
+
+	if !strings.Contains(notes, "# v1.1.0") {
+		t.Errorf("expected release-notes title, got:\n%s", notes)
+	}
+	if !strings.Contains(notes, "⚠ Breaking Changes") {
+		t.Errorf("expected breaking changes section pulled via getsection, got:\n%s", notes)
+	}
+	if !strings.Contains(notes, "changes since v1.0.0") {
+		t.Errorf("expected previous version reference, got:\n%s", notes)
+	}
+}
+
+func TestGetSectionByName(t *testing.T) {
+	sections := []templateSection{
+		{Name: "Features", Commits: []*GroupedCommit{commit("aaa", "feat", false)}},
+	}
+
+	if got := getSectionByName(sections, "Features"); len(got) != 1 {
+		t.Errorf("expected 1 commit for Features, got %d", len(got))
+	}
+	if got := getSectionByName(sections, "Missing"); got != nil {
+		t.Errorf("expected nil for missing section, got %+v", got)
+	}
+}
+
+func TestCommitURLForTemplate(t *testing.T) {
+	if got := commitURLForTemplate(nil, "abc"); got != "" {
+		t.Errorf("expected empty string with nil remote, got %q", got)
+	}
+
+	remote := &RemoteInfo{Provider: "gitlab", Host: "gitlab.com", Owner: "acme", Repo: "widgets"}
+	want := "https://gitlab.com/acme/widgets/-/commit/abc123"
+	if got := commitURLForTemplate(remote, "abc123"); got != want {
+		t.Errorf("commitURLForTemplate() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,37 @@
+package commitparser
+
+import (
+	"fmt"
+	"os"
+)
+
+var (
+	defaultCommitParser    CommitParser
+	RegisterCommitParserFn = registerCommitParser
+	GetCommitParserFn      = getCommitParser
+)
+
+func registerCommitParser(p CommitParser) {
+	if defaultCommitParser != nil {
+		fmt.Fprintf(os.Stderr,
+			"WARNING: Ignoring commit parser %q: another parser (%q) is already registered.\n",
+			p.Name(), defaultCommitParser.Name(),
+		)
+		return
+	}
+	defaultCommitParser = p
+}
+
+func getCommitParser() CommitParser {
+	return defaultCommitParser
+}
+
+// Unregister removes the registered commit parser.
+func Unregister() {
+	defaultCommitParser = nil
+}
+
+// ResetCommitParser clears the registered commit parser (for testing).
+func ResetCommitParser() {
+	defaultCommitParser = nil
+}
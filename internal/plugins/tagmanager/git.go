@@ -3,6 +3,7 @@ package tagmanager
 import (
 	"bytes"
 	"fmt"
+	"os"
 	"os/exec"
 	"strings"
 
@@ -12,6 +13,10 @@ import (
 // OSGitTagOperations implements core.GitTagOperations using actual git commands.
 type OSGitTagOperations struct {
 	execCommand func(name string, arg ...string) *exec.Cmd
+	// dir, when set, scopes every git invocation to this working tree via "-C dir".
+	// It is populated by WithWorktree to isolate tag mutations from the user's
+	// current checkout.
+	dir string
 }
 
 // NewOSGitTagOperations creates a new OSGitTagOperations with the default exec.Command.
@@ -21,11 +26,65 @@ func NewOSGitTagOperations() *OSGitTagOperations {
 	}
 }
 
+// WithWorktree checks out ref into a new temporary git worktree and returns an
+// OSGitTagOperations scoped to it, so tag-mutating calls (CreateAnnotatedTag,
+// CreateLightweightTag, PushTag, DeleteTag) run against that isolated checkout
+// instead of the caller's current working tree. The returned cleanup func
+// removes the worktree directory and prunes it from git's worktree registry;
+// callers must invoke it (typically via defer) once done. Pair it with
+// TagManagerPlugin.SetGitOps so a release flow can tag multiple modules from
+// their own worktrees concurrently without disturbing in-progress user edits.
+func WithWorktree(ref string) (*OSGitTagOperations, func() error, error) {
+	tmpDir, err := os.MkdirTemp("", "sley-tag-worktree-")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create worktree directory: %w", err)
+	}
+
+	cmd := exec.Command("git", "worktree", "add", tmpDir, ref)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		_ = os.RemoveAll(tmpDir)
+		stderrMsg := strings.TrimSpace(stderr.String())
+		if stderrMsg != "" {
+			return nil, nil, fmt.Errorf("%s: %w", stderrMsg, err)
+		}
+		return nil, nil, fmt.Errorf("git worktree add failed: %w", err)
+	}
+
+	ops := &OSGitTagOperations{
+		execCommand: exec.Command,
+		dir:         tmpDir,
+	}
+
+	cleanup := func() error {
+		if err := os.RemoveAll(tmpDir); err != nil {
+			return fmt.Errorf("failed to remove worktree directory: %w", err)
+		}
+		pruneCmd := exec.Command("git", "worktree", "prune")
+		if err := pruneCmd.Run(); err != nil {
+			return fmt.Errorf("git worktree prune failed: %w", err)
+		}
+		return nil
+	}
+
+	return ops, cleanup, nil
+}
+
 // Verify OSGitTagOperations implements core.GitTagOperations.
 var _ core.GitTagOperations = (*OSGitTagOperations)(nil)
 
+// gitArgs prepends a "-C dir" scope to args when g.dir is set, so every
+// command issued by this OSGitTagOperations targets its bound worktree.
+func (g *OSGitTagOperations) gitArgs(args ...string) []string {
+	if g.dir == "" {
+		return args
+	}
+	return append([]string{"-C", g.dir}, args...)
+}
+
 func (g *OSGitTagOperations) CreateAnnotatedTag(name, message string) error {
-	cmd := g.execCommand("git", "tag", "-a", name, "-m", message)
+	cmd := g.execCommand("git", g.gitArgs("tag", "-a", name, "-m", message)...)
 	var stderr bytes.Buffer
 	cmd.Stderr = &stderr
 
@@ -40,7 +99,7 @@ func (g *OSGitTagOperations) CreateAnnotatedTag(name, message string) error {
 }
 
 func (g *OSGitTagOperations) CreateLightweightTag(name string) error {
-	cmd := g.execCommand("git", "tag", name)
+	cmd := g.execCommand("git", g.gitArgs("tag", name)...)
 	var stderr bytes.Buffer
 	cmd.Stderr = &stderr
 
@@ -55,11 +114,35 @@ func (g *OSGitTagOperations) CreateLightweightTag(name string) error {
 }
 
 func (g *OSGitTagOperations) CreateSignedTag(name, message, keyID string) error {
-	var args []string
-	if keyID != "" {
-		args = []string{"tag", "-s", "-u", keyID, name, "-m", message}
-	} else {
-		args = []string{"tag", "-s", name, "-m", message}
+	return g.CreateSignedTagWithOptions(name, message, TagOptions{SigningKey: keyID})
+}
+
+// TagOptions configures how CreateSignedTagWithOptions signs a tag.
+type TagOptions struct {
+	// SigningKey is the key ID to sign with (a GPG key ID, or an SSH key
+	// path/fingerprint when Format is "ssh"). If empty, git falls back to
+	// its own configured default (user.signingkey).
+	SigningKey string
+
+	// Format selects the signature backend: "gpg" (the default when empty)
+	// or "ssh". "ssh" is applied as a one-shot "-c gpg.format=ssh" override
+	// so it doesn't require changing the user's global git config.
+	Format string
+}
+
+// CreateSignedTagWithOptions creates a GPG- or SSH-signed annotated tag,
+// honoring opts.SigningKey (translated to "-u <key>") and opts.Format
+// (translated to a "-c gpg.format=ssh" override when "ssh").
+func (g *OSGitTagOperations) CreateSignedTagWithOptions(name, message string, opts TagOptions) error {
+	tagArgs := []string{"tag", "-s"}
+	if opts.SigningKey != "" {
+		tagArgs = append(tagArgs, "-u", opts.SigningKey)
+	}
+	tagArgs = append(tagArgs, name, "-m", message)
+
+	args := g.gitArgs(tagArgs...)
+	if opts.Format == "ssh" {
+		args = append([]string{"-c", "gpg.format=ssh"}, args...)
 	}
 
 	cmd := g.execCommand("git", args...)
@@ -76,8 +159,101 @@ func (g *OSGitTagOperations) CreateSignedTag(name, message, keyID string) error
 	return nil
 }
 
+// VerifyResult describes the outcome of verifying a signed tag via
+// "git tag -v".
+type VerifyResult struct {
+	// Signed is true if git reported a signature (good or bad) on the tag.
+	Signed bool
+
+	// Good is true if the signature verified successfully.
+	Good bool
+
+	// Signer is the identity line from the signature
+	// (e.g. "Jane Doe <jane@example.com>"), when reported.
+	Signer string
+
+	// KeyFingerprint is the signing key's fingerprint or key ID, when reported.
+	KeyFingerprint string
+
+	// TrustLevel is git/gpg's trust annotation for the key
+	// (e.g. "full", "ultimate", "undefined"), when reported.
+	TrustLevel string
+
+	// Output is the raw combined stdout+stderr of "git tag -v", for callers
+	// that want more detail than the parsed fields capture.
+	Output string
+}
+
+// VerifyTag runs "git tag -v <name>" and parses the signer identity, key
+// fingerprint, and trust level out of gpg's verification output.
+func (g *OSGitTagOperations) VerifyTag(name string) (VerifyResult, error) {
+	cmd := g.execCommand("git", g.gitArgs("tag", "-v", name)...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	runErr := cmd.Run()
+	output := stdout.String() + stderr.String()
+	result := parseVerifyTagOutput(output)
+
+	if runErr != nil && !result.Good {
+		stderrMsg := strings.TrimSpace(stderr.String())
+		if stderrMsg != "" {
+			return result, fmt.Errorf("%s: %w", stderrMsg, runErr)
+		}
+		return result, fmt.Errorf("git tag -v failed: %w", runErr)
+	}
+	return result, nil
+}
+
+// parseVerifyTagOutput extracts the signer identity, key fingerprint, and
+// trust level from the combined output of "git tag -v" / "gpg --verify".
+func parseVerifyTagOutput(output string) VerifyResult {
+	result := VerifyResult{Output: output}
+
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case strings.Contains(line, "Good signature from"):
+			result.Signed = true
+			result.Good = true
+			result.Signer = quotedSubstring(line)
+		case strings.Contains(line, "BAD signature from"):
+			result.Signed = true
+			result.Good = false
+			result.Signer = quotedSubstring(line)
+		case strings.Contains(line, "using") && strings.Contains(line, "key"):
+			if fields := strings.Fields(line); len(fields) > 0 {
+				result.KeyFingerprint = fields[len(fields)-1]
+			}
+		}
+
+		if result.Good {
+			if idx := strings.LastIndex(line, "["); idx >= 0 && strings.HasSuffix(line, "]") {
+				result.TrustLevel = strings.TrimSuffix(line[idx+1:], "]")
+			}
+		}
+	}
+
+	return result
+}
+
+// quotedSubstring returns the text between the first and last double quote
+// in line, or "" if line doesn't contain a quoted substring.
+func quotedSubstring(line string) string {
+	start := strings.Index(line, "\"")
+	if start < 0 {
+		return ""
+	}
+	end := strings.LastIndex(line, "\"")
+	if end <= start {
+		return ""
+	}
+	return line[start+1 : end]
+}
+
 func (g *OSGitTagOperations) TagExists(name string) (bool, error) {
-	cmd := g.execCommand("git", "tag", "-l", name)
+	cmd := g.execCommand("git", g.gitArgs("tag", "-l", name)...)
 	var stdout bytes.Buffer
 	cmd.Stdout = &stdout
 
@@ -91,7 +267,7 @@ func (g *OSGitTagOperations) TagExists(name string) (bool, error) {
 }
 
 func (g *OSGitTagOperations) GetLatestTag() (string, error) {
-	cmd := g.execCommand("git", "describe", "--tags", "--abbrev=0")
+	cmd := g.execCommand("git", g.gitArgs("describe", "--tags", "--abbrev=0")...)
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
@@ -113,7 +289,7 @@ func (g *OSGitTagOperations) GetLatestTag() (string, error) {
 }
 
 func (g *OSGitTagOperations) PushTag(name string) error {
-	cmd := g.execCommand("git", "push", "origin", name)
+	cmd := g.execCommand("git", g.gitArgs("push", "origin", name)...)
 	var stderr bytes.Buffer
 	cmd.Stderr = &stderr
 
@@ -127,6 +303,69 @@ func (g *OSGitTagOperations) PushTag(name string) error {
 	return nil
 }
 
+// PushRefs pushes one or more refs (e.g. tag names) to remote in a single
+// invocation, atomically (all-or-nothing) when atomic is true via
+// "git push --atomic". Used to push an exact release tag together with the
+// alias tags CreateTag just moved, so a partial push can never leave an
+// alias pointing at a commit the exact tag hasn't reached.
+func (g *OSGitTagOperations) PushRefs(remote string, atomic bool, refs []string) error {
+	args := []string{"push"}
+	if atomic {
+		args = append(args, "--atomic")
+	}
+	args = append(args, remote)
+	args = append(args, refs...)
+
+	cmd := g.execCommand("git", g.gitArgs(args...)...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		stderrMsg := strings.TrimSpace(stderr.String())
+		if stderrMsg != "" {
+			return fmt.Errorf("%s: %w", stderrMsg, err)
+		}
+		return fmt.Errorf("git push failed: %w", err)
+	}
+	return nil
+}
+
+// DeleteTag deletes a local git tag, scoped to this operations instance's
+// worktree when one is bound via WithWorktree.
+func (g *OSGitTagOperations) DeleteTag(name string) error {
+	cmd := g.execCommand("git", g.gitArgs("tag", "-d", name)...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		stderrMsg := strings.TrimSpace(stderr.String())
+		if stderrMsg != "" {
+			return fmt.Errorf("%s: %w", stderrMsg, err)
+		}
+		return fmt.Errorf("git tag delete failed: %w", err)
+	}
+	return nil
+}
+
+// ForceCreateLightweightTag creates or moves a lightweight tag to the current
+// commit via "git tag -f", overwriting it if it already exists. Intended for
+// floating aliases (e.g. "v1", "v1.2", "latest") that are expected to move
+// across releases, not for the immutable exact-version tag.
+func (g *OSGitTagOperations) ForceCreateLightweightTag(name string) error {
+	cmd := g.execCommand("git", g.gitArgs("tag", "-f", name)...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		stderrMsg := strings.TrimSpace(stderr.String())
+		if stderrMsg != "" {
+			return fmt.Errorf("%s: %w", stderrMsg, err)
+		}
+		return fmt.Errorf("git tag (force) failed: %w", err)
+	}
+	return nil
+}
+
 // defaultGitTagOps is the default git tag operations for backward compatibility.
 var defaultGitTagOps = NewOSGitTagOperations()
 
@@ -135,11 +374,18 @@ var defaultGitTagOps = NewOSGitTagOperations()
 var (
 	createAnnotatedTagFn   = func(name, message string) error { return defaultGitTagOps.CreateAnnotatedTag(name, message) }
 	createLightweightTagFn = func(name string) error { return defaultGitTagOps.CreateLightweightTag(name) }
-	createSignedTagFn      = func(name, message, keyID string) error { return defaultGitTagOps.CreateSignedTag(name, message, keyID) }
-	tagExistsFn            = func(name string) (bool, error) { return defaultGitTagOps.TagExists(name) }
-	getLatestTagFn         = func() (string, error) { return defaultGitTagOps.GetLatestTag() }
-	pushTagFn              = func(name string) error { return defaultGitTagOps.PushTag(name) }
-	execCommand            = exec.Command
+	createSignedTagFn      = func(name, message string, opts TagOptions) error {
+		return defaultGitTagOps.CreateSignedTagWithOptions(name, message, opts)
+	}
+	forceCreateLightweightTagFn = func(name string) error { return defaultGitTagOps.ForceCreateLightweightTag(name) }
+	deleteTagFn                 = func(name string) error { return defaultGitTagOps.DeleteTag(name) }
+	tagExistsFn                 = func(name string) (bool, error) { return defaultGitTagOps.TagExists(name) }
+	getLatestTagFn              = func() (string, error) { return defaultGitTagOps.GetLatestTag() }
+	pushRefsFn                  = func(remote string, atomic bool, refs []string) error {
+		return defaultGitTagOps.PushRefs(remote, atomic, refs)
+	}
+	verifyTagFn = func(name string) (VerifyResult, error) { return defaultGitTagOps.VerifyTag(name) }
+	execCommand = exec.Command
 )
 
 // ListTags returns all git tags matching a pattern.
@@ -1,7 +1,9 @@
 package tagmanager
 
 import (
+	"os"
 	"os/exec"
+	"path/filepath"
 	"testing"
 )
 
@@ -95,6 +97,185 @@ func TestOSGitTagOperations_CreateLightweightTag(t *testing.T) {
 	})
 }
 
+func TestOSGitTagOperations_CreateSignedTagWithOptions(t *testing.T) {
+	t.Run("gpg with key", func(t *testing.T) {
+		ops := createTestGitTagOps(func(name string, args ...string) *exec.Cmd {
+			want := []string{"tag", "-s", "-u", "ABCDEF", "v1.0.0", "-m", "Release 1.0.0"}
+			if len(args) != len(want) {
+				t.Fatalf("args = %v, want %v", args, want)
+			}
+			for i := range want {
+				if args[i] != want[i] {
+					t.Errorf("args[%d] = %q, want %q", i, args[i], want[i])
+				}
+			}
+			return exec.Command("true")
+		})
+
+		err := ops.CreateSignedTagWithOptions("v1.0.0", "Release 1.0.0", TagOptions{SigningKey: "ABCDEF", Format: "gpg"})
+		if err != nil {
+			t.Errorf("CreateSignedTagWithOptions() error = %v", err)
+		}
+	})
+
+	t.Run("ssh format without key", func(t *testing.T) {
+		ops := createTestGitTagOps(func(name string, args ...string) *exec.Cmd {
+			want := []string{"-c", "gpg.format=ssh", "tag", "-s", "v1.0.0", "-m", "Release 1.0.0"}
+			if len(args) != len(want) {
+				t.Fatalf("args = %v, want %v", args, want)
+			}
+			for i := range want {
+				if args[i] != want[i] {
+					t.Errorf("args[%d] = %q, want %q", i, args[i], want[i])
+				}
+			}
+			return exec.Command("true")
+		})
+
+		err := ops.CreateSignedTagWithOptions("v1.0.0", "Release 1.0.0", TagOptions{Format: "ssh"})
+		if err != nil {
+			t.Errorf("CreateSignedTagWithOptions() error = %v", err)
+		}
+	})
+
+	t.Run("ssh format scoped to worktree", func(t *testing.T) {
+		ops := &OSGitTagOperations{
+			dir: "/tmp/worktree",
+			execCommand: func(name string, args ...string) *exec.Cmd {
+				want := []string{"-c", "gpg.format=ssh", "-C", "/tmp/worktree", "tag", "-s", "v1.0.0", "-m", "msg"}
+				if len(args) != len(want) {
+					t.Fatalf("args = %v, want %v", args, want)
+				}
+				for i := range want {
+					if args[i] != want[i] {
+						t.Errorf("args[%d] = %q, want %q", i, args[i], want[i])
+					}
+				}
+				return exec.Command("true")
+			},
+		}
+
+		if err := ops.CreateSignedTagWithOptions("v1.0.0", "msg", TagOptions{Format: "ssh"}); err != nil {
+			t.Errorf("CreateSignedTagWithOptions() error = %v", err)
+		}
+	})
+
+	t.Run("error with stderr", func(t *testing.T) {
+		ops := createTestGitTagOps(func(name string, args ...string) *exec.Cmd {
+			return exec.Command("sh", "-c", "echo 'gpg failed to sign' >&2 && exit 1")
+		})
+
+		err := ops.CreateSignedTagWithOptions("v1.0.0", "msg", TagOptions{})
+		if err == nil {
+			t.Error("CreateSignedTagWithOptions() expected error")
+		}
+	})
+}
+
+func TestOSGitTagOperations_CreateSignedTag(t *testing.T) {
+	ops := createTestGitTagOps(func(name string, args ...string) *exec.Cmd {
+		want := []string{"tag", "-s", "-u", "ABCDEF", "v1.0.0", "-m", "msg"}
+		if len(args) != len(want) {
+			t.Fatalf("args = %v, want %v", args, want)
+		}
+		return exec.Command("true")
+	})
+
+	if err := ops.CreateSignedTag("v1.0.0", "msg", "ABCDEF"); err != nil {
+		t.Errorf("CreateSignedTag() error = %v", err)
+	}
+}
+
+func TestParseVerifyTagOutput(t *testing.T) {
+	tests := []struct {
+		name       string
+		output     string
+		wantSigned bool
+		wantGood   bool
+		wantSigner string
+		wantTrust  string
+	}{
+		{
+			name: "good gpg signature",
+			output: `object abc123
+type commit
+tag v1.0.0
+gpg: Signature made Mon Jan  1 00:00:00 2026 UTC
+gpg:                using RSA key ABCDEF0123456789
+gpg: Good signature from "Jane Doe <jane@example.com>" [ultimate]`,
+			wantSigned: true,
+			wantGood:   true,
+			wantSigner: "Jane Doe <jane@example.com>",
+			wantTrust:  "ultimate",
+		},
+		{
+			name: "bad signature",
+			output: `gpg: Signature made Mon Jan  1 00:00:00 2026 UTC
+gpg: BAD signature from "Jane Doe <jane@example.com>"`,
+			wantSigned: true,
+			wantGood:   false,
+			wantSigner: "Jane Doe <jane@example.com>",
+		},
+		{
+			name:       "unsigned tag",
+			output:     "object abc123\ntype commit\ntag v1.0.0",
+			wantSigned: false,
+			wantGood:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := parseVerifyTagOutput(tt.output)
+			if result.Signed != tt.wantSigned {
+				t.Errorf("Signed = %v, want %v", result.Signed, tt.wantSigned)
+			}
+			if result.Good != tt.wantGood {
+				t.Errorf("Good = %v, want %v", result.Good, tt.wantGood)
+			}
+			if result.Signer != tt.wantSigner {
+				t.Errorf("Signer = %q, want %q", result.Signer, tt.wantSigner)
+			}
+			if result.TrustLevel != tt.wantTrust {
+				t.Errorf("TrustLevel = %q, want %q", result.TrustLevel, tt.wantTrust)
+			}
+		})
+	}
+}
+
+func TestOSGitTagOperations_VerifyTag(t *testing.T) {
+	t.Run("good signature", func(t *testing.T) {
+		ops := createTestGitTagOps(func(name string, args ...string) *exec.Cmd {
+			if len(args) < 2 || args[0] != "tag" || args[1] != "-v" {
+				t.Errorf("unexpected args: %v", args)
+			}
+			return exec.Command("sh", "-c", `echo 'gpg: Good signature from "Jane Doe <jane@example.com>" [full]' >&2`)
+		})
+
+		result, err := ops.VerifyTag("v1.0.0")
+		if err != nil {
+			t.Fatalf("VerifyTag() error = %v", err)
+		}
+		if !result.Good {
+			t.Error("expected a good signature")
+		}
+		if result.TrustLevel != "full" {
+			t.Errorf("TrustLevel = %q, want %q", result.TrustLevel, "full")
+		}
+	})
+
+	t.Run("command fails and no signature parsed", func(t *testing.T) {
+		ops := createTestGitTagOps(func(name string, args ...string) *exec.Cmd {
+			return exec.Command("sh", "-c", "echo 'error: no tag found' >&2 && exit 1")
+		})
+
+		_, err := ops.VerifyTag("v1.0.0")
+		if err == nil {
+			t.Error("VerifyTag() expected error")
+		}
+	})
+}
+
 func TestOSGitTagOperations_TagExists(t *testing.T) {
 	t.Run("tag exists", func(t *testing.T) {
 		ops := createTestGitTagOps(func(name string, args ...string) *exec.Cmd {
@@ -346,3 +527,135 @@ func TestNewOSGitTagOperations(t *testing.T) {
 		t.Error("execCommand should not be nil")
 	}
 }
+
+func TestOSGitTagOperations_gitArgs(t *testing.T) {
+	t.Run("no dir", func(t *testing.T) {
+		ops := &OSGitTagOperations{}
+		got := ops.gitArgs("tag", "-l")
+		want := []string{"tag", "-l"}
+		if len(got) != len(want) {
+			t.Fatalf("gitArgs() = %v, want %v", got, want)
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Errorf("gitArgs()[%d] = %q, want %q", i, got[i], want[i])
+			}
+		}
+	})
+
+	t.Run("with dir", func(t *testing.T) {
+		ops := &OSGitTagOperations{dir: "/tmp/worktree"}
+		got := ops.gitArgs("tag", "-l")
+		want := []string{"-C", "/tmp/worktree", "tag", "-l"}
+		if len(got) != len(want) {
+			t.Fatalf("gitArgs() = %v, want %v", got, want)
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Errorf("gitArgs()[%d] = %q, want %q", i, got[i], want[i])
+			}
+		}
+	})
+}
+
+// initTestGitRepo creates a minimal git repository with one commit in dir.
+func initTestGitRepo(t *testing.T, dir string) {
+	t.Helper()
+
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v failed: %v\n%s", args, err, out)
+		}
+	}
+
+	run("init")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "Test User")
+	if err := os.WriteFile(filepath.Join(dir, "README.md"), []byte("test\n"), 0644); err != nil {
+		t.Fatalf("failed to write README.md: %v", err)
+	}
+	run("add", "README.md")
+	run("commit", "-m", "initial commit")
+}
+
+func TestWithWorktree(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	repoDir := t.TempDir()
+	initTestGitRepo(t, repoDir)
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("failed to get working directory: %v", err)
+	}
+	if err := os.Chdir(repoDir); err != nil {
+		t.Fatalf("failed to chdir into repo: %v", err)
+	}
+	defer func() { _ = os.Chdir(cwd) }()
+
+	ops, cleanup, err := WithWorktree("HEAD")
+	if err != nil {
+		t.Fatalf("WithWorktree() error = %v", err)
+	}
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Errorf("cleanup() error = %v", err)
+		}
+	}()
+
+	if ops.dir == "" {
+		t.Fatal("expected ops.dir to be set")
+	}
+	if _, err := os.Stat(filepath.Join(ops.dir, "README.md")); err != nil {
+		t.Errorf("expected worktree to contain checked-out files: %v", err)
+	}
+
+	if err := ops.CreateLightweightTag("v0.1.0-worktree-test"); err != nil {
+		t.Fatalf("CreateLightweightTag() in worktree error = %v", err)
+	}
+
+	exists, err := ops.TagExists("v0.1.0-worktree-test")
+	if err != nil {
+		t.Fatalf("TagExists() error = %v", err)
+	}
+	if !exists {
+		t.Error("expected tag created in worktree to be visible")
+	}
+
+	if err := ops.DeleteTag("v0.1.0-worktree-test"); err != nil {
+		t.Errorf("DeleteTag() error = %v", err)
+	}
+}
+
+func TestWithWorktree_InvalidRef(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	repoDir := t.TempDir()
+	initTestGitRepo(t, repoDir)
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("failed to get working directory: %v", err)
+	}
+	if err := os.Chdir(repoDir); err != nil {
+		t.Fatalf("failed to chdir into repo: %v", err)
+	}
+	defer func() { _ = os.Chdir(cwd) }()
+
+	_, _, err = WithWorktree("does-not-exist")
+	if err == nil {
+		t.Error("WithWorktree() expected error for invalid ref")
+	}
+}
@@ -2,6 +2,9 @@ package tagmanager
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/indaco/sley/internal/semver"
 )
@@ -45,6 +48,27 @@ type Config struct {
 	// Push automatically pushes tags to remote after creation.
 	Push bool
 
+	// Remote is the git remote to push tags to (default: "origin").
+	Remote string
+
+	// PushAtomic pushes the exact release tag and any moved alias tags in a
+	// single "git push --atomic" call, so a partial failure never leaves an
+	// alias pointing at a commit the exact tag hasn't reached.
+	PushAtomic bool
+
+	// PushRetries caps how many additional attempts a push gets after a
+	// transient (network) failure, with exponential backoff between
+	// attempts starting at PushBackoff. Default: 0 (no retries).
+	PushRetries int
+
+	// PushBackoff is the delay before the first retry; it doubles after each
+	// subsequent attempt. Default: 1 second.
+	PushBackoff time.Duration
+
+	// DryRun logs the git push command that would run instead of executing
+	// it. Useful for CI previews.
+	DryRun bool
+
 	// TagPrereleases controls whether tags are created for pre-release versions.
 	// When false, tags are only created for stable releases (major/minor/patch).
 	// Default: true (for backward compatibility).
@@ -60,6 +84,16 @@ type Config struct {
 	// Only used when Sign is true.
 	SigningKey string
 
+	// Format selects the signature backend: "gpg" (the default) or "ssh".
+	// Only used when Sign is true.
+	Format string
+
+	// FloatingTag, when set, names an additional tag (e.g. "latest") that is
+	// force-moved to point at every stable release, alongside the "vMAJOR"
+	// and "vMAJOR.MINOR" aliases CreateTag always maintains. Empty disables
+	// the floating tag. Never applied to pre-release versions.
+	FloatingTag string
+
 	// MessageTemplate is a template for the tag message.
 	// Supports placeholders: {version}, {tag}, {prefix}, {date}, {major}, {minor}, {patch}, {prerelease}, {build}
 	// Default: "Release {version}" for annotated/signed tags.
@@ -74,9 +108,16 @@ func DefaultConfig() *Config {
 		Prefix:          "v",
 		Annotate:        true,
 		Push:            false,
+		Remote:          "origin",
+		PushAtomic:      false,
+		PushRetries:     0,
+		PushBackoff:     time.Second,
+		DryRun:          false,
 		TagPrereleases:  true,
 		Sign:            false,
 		SigningKey:      "",
+		Format:          "gpg",
+		FloatingTag:     "",
 		MessageTemplate: "Release {version}",
 	}
 }
@@ -84,6 +125,14 @@ func DefaultConfig() *Config {
 // TagManagerPlugin implements the TagManager interface.
 type TagManagerPlugin struct {
 	config *Config
+	policy *TagPolicy
+	// gitOps, when set via SetGitOps, scopes every tag-mutating call
+	// (CreateTag, DeleteTag, RetagTo, and the alias tags they update) to this
+	// OSGitTagOperations instance instead of the shared package-level
+	// defaultGitTagOps. Pair it with WithWorktree so a multi-module release
+	// flow can tag and push several modules concurrently from isolated
+	// worktrees without racing on a single current working directory.
+	gitOps *OSGitTagOperations
 }
 
 // Ensure TagManagerPlugin implements TagManager.
@@ -108,17 +157,75 @@ func (p *TagManagerPlugin) FormatTagName(version semver.SemVersion) string {
 	return p.config.Prefix + version.String()
 }
 
+// SetPolicy installs a TagPolicy that CreateTag (via ValidateTagAvailable),
+// DeleteTag, and RetagTo consult before mutating refs. A nil policy (the
+// default) disables all policy checks.
+func (p *TagManagerPlugin) SetPolicy(policy *TagPolicy) {
+	p.policy = policy
+}
+
+// SetGitOps installs ops as this plugin's backend for tag-mutating git
+// commands, overriding the shared package-level defaultGitTagOps. Typically
+// ops comes from WithWorktree, so this plugin's CreateTag/DeleteTag/RetagTo
+// calls run against an isolated checkout instead of the caller's current
+// working tree. A nil ops (the default) reverts to defaultGitTagOps.
+func (p *TagManagerPlugin) SetGitOps(ops *OSGitTagOperations) {
+	p.gitOps = ops
+}
+
+// createAnnotatedTag, createLightweightTag, createSignedTag,
+// forceCreateLightweightTag, and deleteTag resolve to p.gitOps when set via
+// SetGitOps, falling back to the package-level Fn vars (and their shared
+// defaultGitTagOps) otherwise.
+
+func (p *TagManagerPlugin) createAnnotatedTag(name, message string) error {
+	if p.gitOps != nil {
+		return p.gitOps.CreateAnnotatedTag(name, message)
+	}
+	return createAnnotatedTagFn(name, message)
+}
+
+func (p *TagManagerPlugin) createLightweightTag(name string) error {
+	if p.gitOps != nil {
+		return p.gitOps.CreateLightweightTag(name)
+	}
+	return createLightweightTagFn(name)
+}
+
+func (p *TagManagerPlugin) createSignedTag(name, message string, opts TagOptions) error {
+	if p.gitOps != nil {
+		return p.gitOps.CreateSignedTagWithOptions(name, message, opts)
+	}
+	return createSignedTagFn(name, message, opts)
+}
+
+func (p *TagManagerPlugin) forceCreateLightweightTag(name string) error {
+	if p.gitOps != nil {
+		return p.gitOps.ForceCreateLightweightTag(name)
+	}
+	return forceCreateLightweightTagFn(name)
+}
+
+func (p *TagManagerPlugin) deleteTag(name string) error {
+	if p.gitOps != nil {
+		return p.gitOps.DeleteTag(name)
+	}
+	return deleteTagFn(name)
+}
+
+func (p *TagManagerPlugin) gitPushRefs(remote string, atomic bool, refs []string) error {
+	if p.gitOps != nil {
+		return p.gitOps.PushRefs(remote, atomic, refs)
+	}
+	return pushRefsFn(remote, atomic, refs)
+}
+
 // CreateTag creates a git tag for the given version.
 func (p *TagManagerPlugin) CreateTag(version semver.SemVersion, message string) error {
 	tagName := p.FormatTagName(version)
 
-	// Check if tag already exists
-	exists, err := p.TagExists(version)
-	if err != nil {
-		return fmt.Errorf("failed to check tag existence: %w", err)
-	}
-	if exists {
-		return fmt.Errorf("tag %s already exists", tagName)
+	if err := p.ValidateTagAvailable(version); err != nil {
+		return err
 	}
 
 	// Format the message using template if no explicit message provided
@@ -134,32 +241,146 @@ func (p *TagManagerPlugin) CreateTag(version semver.SemVersion, message string)
 	// Create the tag based on configuration
 	switch {
 	case p.config.Sign:
-		// GPG-signed tag (implies annotated)
-		if err := createSignedTagFn(tagName, message, p.config.SigningKey); err != nil {
+		// GPG- or SSH-signed tag (implies annotated)
+		opts := TagOptions{SigningKey: p.config.SigningKey, Format: p.config.Format}
+		if err := p.createSignedTag(tagName, message, opts); err != nil {
 			return fmt.Errorf("failed to create signed tag: %w", err)
 		}
 	case p.config.Annotate:
 		// Annotated tag (not signed)
-		if err := createAnnotatedTagFn(tagName, message); err != nil {
+		if err := p.createAnnotatedTag(tagName, message); err != nil {
 			return fmt.Errorf("failed to create annotated tag: %w", err)
 		}
 	default:
 		// Lightweight tag (no message)
-		if err := createLightweightTagFn(tagName); err != nil {
+		if err := p.createLightweightTag(tagName); err != nil {
 			return fmt.Errorf("failed to create lightweight tag: %w", err)
 		}
 	}
 
-	// Optionally push the tag
+	movedAliases, aliasErrs := p.updateAliasTags(version)
+
+	// Push the exact tag and any successfully moved aliases together, so an
+	// atomic push (when configured) can never leave an alias pointing at a
+	// commit the exact tag hasn't reached.
 	if p.config.Push {
-		if err := pushTagFn(tagName); err != nil {
-			return fmt.Errorf("failed to push tag: %w", err)
+		refs := append([]string{tagName}, movedAliases...)
+		if err := p.pushRefs(refs); err != nil {
+			return fmt.Errorf("failed to push tag(s): %w", err)
+		}
+	}
+
+	if len(aliasErrs) > 0 {
+		msgs := make([]string, len(aliasErrs))
+		for i, err := range aliasErrs {
+			msgs[i] = err.Error()
 		}
+		return fmt.Errorf("tag %s created, but %d alias tag(s) failed to update: %s", tagName, len(aliasErrs), strings.Join(msgs, "; "))
+	}
+
+	return nil
+}
+
+// DeleteTag deletes the exact release tag for version, subject to the
+// configured TagPolicy: an Immutable policy refuses to delete any exact
+// release tag, and a Protected glob list refuses to delete tags matching its
+// patterns.
+func (p *TagManagerPlugin) DeleteTag(version semver.SemVersion) error {
+	tagName := p.FormatTagName(version)
+
+	if err := p.checkMutationAllowed(tagName); err != nil {
+		return err
 	}
 
+	return p.deleteTag(tagName)
+}
+
+// RetagTo force-moves the exact release tag for version to the current
+// commit, subject to the same TagPolicy checks as DeleteTag.
+func (p *TagManagerPlugin) RetagTo(version semver.SemVersion) error {
+	tagName := p.FormatTagName(version)
+
+	if err := p.checkMutationAllowed(tagName); err != nil {
+		return err
+	}
+
+	if exists, err := tagExistsFn(tagName); err == nil && exists {
+		if err := p.deleteTag(tagName); err != nil {
+			return fmt.Errorf("failed to delete tag %s before retagging: %w", tagName, err)
+		}
+	}
+
+	return p.forceCreateLightweightTag(tagName)
+}
+
+// checkMutationAllowed reports whether tagName may be deleted or moved under
+// the configured TagPolicy. A nil policy allows every mutation.
+func (p *TagManagerPlugin) checkMutationAllowed(tagName string) error {
+	if p.policy == nil {
+		return nil
+	}
+	if p.policy.Immutable {
+		return fmt.Errorf("tag %s: %w", tagName, ErrTagImmutable)
+	}
+	if p.policy.isProtected(tagName) {
+		return fmt.Errorf("tag %s: %w", tagName, ErrTagProtected)
+	}
 	return nil
 }
 
+// aliasTagNames returns the floating tag names that should track version:
+// "vMAJOR", "vMAJOR.MINOR", and (if configured) the FloatingTag name. Returns
+// nil for pre-release versions, which are never aliased.
+func (p *TagManagerPlugin) aliasTagNames(version semver.SemVersion) []string {
+	if version.PreRelease != "" {
+		return nil
+	}
+
+	aliases := []string{
+		fmt.Sprintf("%s%d", p.config.Prefix, version.Major),
+		fmt.Sprintf("%s%d.%d", p.config.Prefix, version.Major, version.Minor),
+	}
+	if p.config.FloatingTag != "" {
+		aliases = append(aliases, p.config.FloatingTag)
+	}
+	return aliases
+}
+
+// updateAliasTags force-moves each alias tag for version to the current
+// commit, deleting any existing local ref first so a stale annotated tag
+// doesn't block the lightweight re-creation. Each alias is independent: a
+// failure on one doesn't stop the others; every failure is collected so
+// callers can report them all instead of aborting on the first. Pushing is
+// left to the caller, which pushes the exact tag and the returned moved
+// aliases together in a single call.
+func (p *TagManagerPlugin) updateAliasTags(version semver.SemVersion) (moved []string, errs []error) {
+	for _, alias := range p.aliasTagNames(version) {
+		if exists, err := tagExistsFn(alias); err == nil && exists {
+			if err := p.deleteTag(alias); err != nil {
+				errs = append(errs, fmt.Errorf("failed to delete alias tag %s: %w", alias, err))
+				continue
+			}
+		}
+
+		if err := p.forceCreateLightweightTag(alias); err != nil {
+			errs = append(errs, fmt.Errorf("failed to move alias tag %s: %w", alias, err))
+			continue
+		}
+
+		moved = append(moved, alias)
+	}
+
+	return moved, errs
+}
+
+// Verify checks the signature on version's tag via "git tag -v", so release
+// flows can gate publication on the tag actually being signed and the
+// signature verifying cleanly.
+func (p *TagManagerPlugin) Verify(version semver.SemVersion) (VerifyResult, error) {
+	tagName := p.FormatTagName(version)
+	return verifyTagFn(tagName)
+}
+
 // FormatTagMessage formats a tag message using the configured template.
 func (p *TagManagerPlugin) FormatTagMessage(version semver.SemVersion) string {
 	template := p.config.MessageTemplate
@@ -182,8 +403,12 @@ func (p *TagManagerPlugin) GetLatestTag() (semver.SemVersion, error) {
 	if err != nil {
 		return semver.SemVersion{}, err
 	}
+	return p.parseTagVersion(tag)
+}
 
-	// Strip prefix if present
+// parseTagVersion strips the configured prefix from tag and parses the
+// remainder as a semantic version.
+func (p *TagManagerPlugin) parseTagVersion(tag string) (semver.SemVersion, error) {
 	versionStr := tag
 	if len(tag) > len(p.config.Prefix) && tag[:len(p.config.Prefix)] == p.config.Prefix {
 		versionStr = tag[len(p.config.Prefix):]
@@ -197,17 +422,127 @@ func (p *TagManagerPlugin) GetLatestTag() (semver.SemVersion, error) {
 	return version, nil
 }
 
-// ValidateTagAvailable ensures a tag can be created for the version.
+// GetLatestStableTag returns the most recent tag that parses as a semantic
+// version and carries no pre-release component, ignoring any pre-release
+// tags (e.g. "-rc.1") even if one of those is the most recently created tag.
+func (p *TagManagerPlugin) GetLatestStableTag() (semver.SemVersion, error) {
+	tags, err := listTagsWithOptionsFn(ListTagOptions{
+		Pattern:    p.config.Prefix + "*",
+		SemverOnly: true,
+		Sort:       "-version:refname",
+	})
+	if err != nil {
+		return semver.SemVersion{}, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	for _, tag := range tags {
+		version, err := p.parseTagVersion(tag.Name)
+		if err != nil {
+			continue
+		}
+		if version.PreRelease == "" {
+			return version, nil
+		}
+	}
+
+	return semver.SemVersion{}, fmt.Errorf("no stable tags found")
+}
+
+// NextPrerelease computes the next pre-release version of the given kind
+// (e.g. "rc", "alpha", "beta") for the current release base: the
+// MAJOR.MINOR.PATCH of the latest tag, stable or not. It scans existing
+// tags sharing that base for the highest "-kind.N" suffix and returns N+1,
+// or 1 if no such pre-release tag exists yet. This lets release automation
+// drive v1.2.0-rc.1 -> rc.2 -> (via Promote) v1.2.0 without reimplementing
+// prerelease parsing.
+func (p *TagManagerPlugin) NextPrerelease(kind string) (semver.SemVersion, error) {
+	latest, err := getLatestTagFn()
+	if err != nil {
+		return semver.SemVersion{}, err
+	}
+
+	base, err := p.parseTagVersion(latest)
+	if err != nil {
+		return semver.SemVersion{}, err
+	}
+	base.PreRelease = ""
+	base.Build = ""
+
+	tags, err := listTagsWithOptionsFn(ListTagOptions{
+		Pattern:    p.config.Prefix + "*",
+		SemverOnly: true,
+	})
+	if err != nil {
+		return semver.SemVersion{}, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	prefix := kind + "."
+	maxN := 0
+	for _, tag := range tags {
+		version, err := p.parseTagVersion(tag.Name)
+		if err != nil {
+			continue
+		}
+		if version.Major != base.Major || version.Minor != base.Minor || version.Patch != base.Patch {
+			continue
+		}
+		if !strings.HasPrefix(version.PreRelease, prefix) {
+			continue
+		}
+		n, err := strconv.Atoi(strings.TrimPrefix(version.PreRelease, prefix))
+		if err != nil {
+			continue
+		}
+		if n > maxN {
+			maxN = n
+		}
+	}
+
+	next := base
+	next.PreRelease = fmt.Sprintf("%s.%d", kind, maxN+1)
+	return next, nil
+}
+
+// Promote strips the pre-release (and build) suffix from pre, producing the
+// GA version that a release flow tags once a release candidate is accepted.
+func (p *TagManagerPlugin) Promote(pre semver.SemVersion) semver.SemVersion {
+	ga := pre
+	ga.PreRelease = ""
+	ga.Build = ""
+	return ga
+}
+
+// ValidateTagAvailable ensures a tag can be created for the version. When a
+// TagPolicy is set via SetPolicy, an already-existing tag is reported with a
+// structured error (ErrTagImmutable, ErrTagProtected, ErrSignatureRequired)
+// instead of the generic "tag already exists".
 func (p *TagManagerPlugin) ValidateTagAvailable(version semver.SemVersion) error {
 	exists, err := p.TagExists(version)
 	if err != nil {
 		return fmt.Errorf("failed to check tag availability: %w", err)
 	}
-	if exists {
-		tagName := p.FormatTagName(version)
-		return fmt.Errorf("tag %s already exists", tagName)
+	if !exists {
+		return nil
 	}
-	return nil
+
+	tagName := p.FormatTagName(version)
+
+	if p.policy != nil {
+		if p.policy.Immutable {
+			return fmt.Errorf("tag %s: %w", tagName, ErrTagImmutable)
+		}
+		if p.policy.isProtected(tagName) {
+			return fmt.Errorf("tag %s: %w", tagName, ErrTagProtected)
+		}
+		if p.policy.RequireSignature {
+			result, verifyErr := verifyTagFn(tagName)
+			if verifyErr != nil || !result.Good {
+				return fmt.Errorf("tag %s: %w", tagName, ErrSignatureRequired)
+			}
+		}
+	}
+
+	return fmt.Errorf("tag %s already exists", tagName)
 }
 
 // IsEnabled returns whether auto-create is enabled.
@@ -2,6 +2,8 @@ package tagmanager
 
 import (
 	"errors"
+	"os/exec"
+	"strings"
 	"testing"
 
 	"github.com/indaco/sley/internal/semver"
@@ -174,13 +176,17 @@ func TestTagManagerPlugin_CreateTag(t *testing.T) {
 	origTagExists := tagExistsFn
 	origCreateAnnotated := createAnnotatedTagFn
 	origCreateLightweight := createLightweightTagFn
-	origPushTag := pushTagFn
+	origPushRefs := pushRefsFn
+	origForceCreateLightweight := forceCreateLightweightTagFn
+	origDeleteTag := deleteTagFn
 
 	defer func() {
 		tagExistsFn = origTagExists
 		createAnnotatedTagFn = origCreateAnnotated
 		createLightweightTagFn = origCreateLightweight
-		pushTagFn = origPushTag
+		pushRefsFn = origPushRefs
+		forceCreateLightweightTagFn = origForceCreateLightweight
+		deleteTagFn = origDeleteTag
 	}()
 
 	tests := []struct {
@@ -260,11 +266,14 @@ func TestTagManagerPlugin_CreateTag(t *testing.T) {
 				return tt.createErr
 			}
 
-			pushTagFn = func(name string) error {
+			pushRefsFn = func(remote string, atomic bool, refs []string) error {
 				pushCalled = true
 				return tt.pushErr
 			}
 
+			forceCreateLightweightTagFn = func(name string) error { return nil }
+			deleteTagFn = func(name string) error { return nil }
+
 			tm := NewTagManager(tt.cfg)
 			err := tm.CreateTag(tt.version, tt.message)
 
@@ -281,13 +290,75 @@ func TestTagManagerPlugin_CreateTag(t *testing.T) {
 					t.Error("CreateTag() should have called createLightweightTag")
 				}
 				if tt.wantPushCalled && !pushCalled {
-					t.Error("CreateTag() should have called pushTag")
+					t.Error("CreateTag() should have called pushRefs")
 				}
 			}
 		})
 	}
 }
 
+func TestTagManagerPlugin_CreateTag_Signed(t *testing.T) {
+	origTagExists := tagExistsFn
+	origCreateSigned := createSignedTagFn
+	origForceCreateLightweight := forceCreateLightweightTagFn
+	origDeleteTag := deleteTagFn
+
+	defer func() {
+		tagExistsFn = origTagExists
+		createSignedTagFn = origCreateSigned
+		forceCreateLightweightTagFn = origForceCreateLightweight
+		deleteTagFn = origDeleteTag
+	}()
+
+	forceCreateLightweightTagFn = func(name string) error { return nil }
+	deleteTagFn = func(name string) error { return nil }
+
+	tests := []struct {
+		name       string
+		format     string
+		signingKey string
+		wantFormat string
+		wantKey    string
+	}{
+		{name: "gpg signing", format: "gpg", signingKey: "ABCDEF", wantFormat: "gpg", wantKey: "ABCDEF"},
+		{name: "ssh signing", format: "ssh", signingKey: "/home/user/.ssh/id_ed25519.pub", wantFormat: "ssh", wantKey: "/home/user/.ssh/id_ed25519.pub"},
+		{name: "default key", format: "gpg", signingKey: "", wantFormat: "gpg", wantKey: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotOpts TagOptions
+			signedCalled := false
+
+			tagExistsFn = func(name string) (bool, error) { return false, nil }
+			createSignedTagFn = func(name, message string, opts TagOptions) error {
+				signedCalled = true
+				gotOpts = opts
+				return nil
+			}
+
+			cfg := &Config{
+				Enabled: true, AutoCreate: true, Prefix: "v",
+				Sign: true, SigningKey: tt.signingKey, Format: tt.format,
+			}
+			tm := NewTagManager(cfg)
+
+			if err := tm.CreateTag(semver.SemVersion{Major: 1}, "Release 1.0.0"); err != nil {
+				t.Fatalf("CreateTag() error = %v", err)
+			}
+			if !signedCalled {
+				t.Fatal("CreateTag() should have called createSignedTagFn")
+			}
+			if gotOpts.Format != tt.wantFormat {
+				t.Errorf("opts.Format = %q, want %q", gotOpts.Format, tt.wantFormat)
+			}
+			if gotOpts.SigningKey != tt.wantKey {
+				t.Errorf("opts.SigningKey = %q, want %q", gotOpts.SigningKey, tt.wantKey)
+			}
+		})
+	}
+}
+
 func TestTagManagerPlugin_GetLatestTag(t *testing.T) {
 	original := getLatestTagFn
 	defer func() { getLatestTagFn = original }()
@@ -350,6 +421,166 @@ func TestTagManagerPlugin_GetLatestTag(t *testing.T) {
 	}
 }
 
+func TestTagManagerPlugin_GetLatestStableTag(t *testing.T) {
+	original := listTagsWithOptionsFn
+	defer func() { listTagsWithOptionsFn = original }()
+
+	t.Run("skips pre-release tags", func(t *testing.T) {
+		listTagsWithOptionsFn = func(opts ListTagOptions) ([]TagInfo, error) {
+			return []TagInfo{
+				{Name: "v1.3.0-rc.1"},
+				{Name: "v1.2.0"},
+				{Name: "v1.1.0"},
+			}, nil
+		}
+
+		tm := NewTagManager(&Config{Prefix: "v"})
+		got, err := tm.GetLatestStableTag()
+		if err != nil {
+			t.Fatalf("GetLatestStableTag() error = %v", err)
+		}
+		want := semver.SemVersion{Major: 1, Minor: 2, Patch: 0}
+		if got != want {
+			t.Errorf("GetLatestStableTag() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("errors when no stable tags exist", func(t *testing.T) {
+		listTagsWithOptionsFn = func(opts ListTagOptions) ([]TagInfo, error) {
+			return []TagInfo{{Name: "v1.0.0-alpha.1"}}, nil
+		}
+
+		tm := NewTagManager(&Config{Prefix: "v"})
+		if _, err := tm.GetLatestStableTag(); err == nil {
+			t.Error("GetLatestStableTag() expected an error when no stable tag exists")
+		}
+	})
+
+	t.Run("propagates list errors", func(t *testing.T) {
+		listTagsWithOptionsFn = func(opts ListTagOptions) ([]TagInfo, error) {
+			return nil, errors.New("git error")
+		}
+
+		tm := NewTagManager(&Config{Prefix: "v"})
+		if _, err := tm.GetLatestStableTag(); err == nil {
+			t.Error("GetLatestStableTag() expected an error when listing fails")
+		}
+	})
+}
+
+func TestTagManagerPlugin_NextPrerelease(t *testing.T) {
+	origGetLatestTag := getLatestTagFn
+	origListTags := listTagsWithOptionsFn
+	defer func() {
+		getLatestTagFn = origGetLatestTag
+		listTagsWithOptionsFn = origListTags
+	}()
+
+	t.Run("increments N for an existing prerelease series", func(t *testing.T) {
+		getLatestTagFn = func() (string, error) { return "v1.2.0-rc.1", nil }
+		listTagsWithOptionsFn = func(opts ListTagOptions) ([]TagInfo, error) {
+			return []TagInfo{
+				{Name: "v1.2.0-rc.1"},
+				{Name: "v1.1.0-rc.3"},
+			}, nil
+		}
+
+		tm := NewTagManager(&Config{Prefix: "v"})
+		got, err := tm.NextPrerelease("rc")
+		if err != nil {
+			t.Fatalf("NextPrerelease() error = %v", err)
+		}
+		want := semver.SemVersion{Major: 1, Minor: 2, Patch: 0, PreRelease: "rc.2"}
+		if got != want {
+			t.Errorf("NextPrerelease() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("starts at N=1 when no prerelease of that kind exists for the base", func(t *testing.T) {
+		getLatestTagFn = func() (string, error) { return "v2.0.0", nil }
+		listTagsWithOptionsFn = func(opts ListTagOptions) ([]TagInfo, error) {
+			return []TagInfo{{Name: "v2.0.0"}}, nil
+		}
+
+		tm := NewTagManager(&Config{Prefix: "v"})
+		got, err := tm.NextPrerelease("alpha")
+		if err != nil {
+			t.Fatalf("NextPrerelease() error = %v", err)
+		}
+		want := semver.SemVersion{Major: 2, Minor: 0, Patch: 0, PreRelease: "alpha.1"}
+		if got != want {
+			t.Errorf("NextPrerelease() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("propagates GetLatestTag errors", func(t *testing.T) {
+		getLatestTagFn = func() (string, error) { return "", errors.New("no tags found") }
+
+		tm := NewTagManager(&Config{Prefix: "v"})
+		if _, err := tm.NextPrerelease("rc"); err == nil {
+			t.Error("NextPrerelease() expected an error when GetLatestTag fails")
+		}
+	})
+}
+
+func TestTagManagerPlugin_Promote(t *testing.T) {
+	tm := NewTagManager(&Config{Prefix: "v"})
+
+	got := tm.Promote(semver.SemVersion{Major: 1, Minor: 2, Patch: 0, PreRelease: "rc.2"})
+	want := semver.SemVersion{Major: 1, Minor: 2, Patch: 0}
+	if got != want {
+		t.Errorf("Promote() = %v, want %v", got, want)
+	}
+}
+
+func TestTagManagerPlugin_Verify(t *testing.T) {
+	original := verifyTagFn
+	defer func() { verifyTagFn = original }()
+
+	tests := []struct {
+		name       string
+		mockResult VerifyResult
+		mockErr    error
+		wantErr    bool
+	}{
+		{
+			name:       "good signature",
+			mockResult: VerifyResult{Signed: true, Good: true, Signer: "Jane Doe <jane@example.com>"},
+			wantErr:    false,
+		},
+		{
+			name:    "verification failed",
+			mockErr: errors.New("gpg: no valid signature found"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotTagName string
+			verifyTagFn = func(name string) (VerifyResult, error) {
+				gotTagName = name
+				return tt.mockResult, tt.mockErr
+			}
+
+			cfg := &Config{Prefix: "v"}
+			tm := NewTagManager(cfg)
+
+			got, err := tm.Verify(semver.SemVersion{Major: 1, Minor: 2, Patch: 3})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotTagName != "v1.2.3" {
+				t.Errorf("verifyTagFn called with %q, want %q", gotTagName, "v1.2.3")
+			}
+			if !tt.wantErr && got != tt.mockResult {
+				t.Errorf("Verify() = %+v, want %+v", got, tt.mockResult)
+			}
+		})
+	}
+}
+
 func TestTagManagerPlugin_IsEnabled(t *testing.T) {
 	tests := []struct {
 		name string
@@ -435,15 +666,161 @@ func TestTagManagerPlugin_ValidateTagAvailable_Error(t *testing.T) {
 	}
 }
 
+func TestTagManagerPlugin_CreateTag_Aliases(t *testing.T) {
+	origTagExists := tagExistsFn
+	origCreateAnnotated := createAnnotatedTagFn
+	origCreateLightweight := createLightweightTagFn
+	origForceCreateLightweight := forceCreateLightweightTagFn
+	origDeleteTag := deleteTagFn
+	origPushRefs := pushRefsFn
+
+	defer func() {
+		tagExistsFn = origTagExists
+		createAnnotatedTagFn = origCreateAnnotated
+		createLightweightTagFn = origCreateLightweight
+		forceCreateLightweightTagFn = origForceCreateLightweight
+		deleteTagFn = origDeleteTag
+		pushRefsFn = origPushRefs
+	}()
+
+	t.Run("moves major and minor aliases for a stable release", func(t *testing.T) {
+		var moved []string
+		var deleted []string
+
+		tagExistsFn = func(name string) (bool, error) { return name == "v1", nil }
+		createAnnotatedTagFn = func(name, msg string) error { return nil }
+		deleteTagFn = func(name string) error {
+			deleted = append(deleted, name)
+			return nil
+		}
+		forceCreateLightweightTagFn = func(name string) error {
+			moved = append(moved, name)
+			return nil
+		}
+
+		cfg := &Config{Enabled: true, AutoCreate: true, Prefix: "v", Annotate: true}
+		tm := NewTagManager(cfg)
+
+		if err := tm.CreateTag(semver.SemVersion{Major: 1, Minor: 2, Patch: 3}, "Release 1.2.3"); err != nil {
+			t.Fatalf("CreateTag() error = %v", err)
+		}
+
+		if len(deleted) != 1 || deleted[0] != "v1" {
+			t.Errorf("deleted = %v, want only [v1] (pre-existing alias)", deleted)
+		}
+		if len(moved) != 2 || moved[0] != "v1" || moved[1] != "v1.2" {
+			t.Errorf("moved = %v, want [v1 v1.2]", moved)
+		}
+	})
+
+	t.Run("also moves the floating tag when configured", func(t *testing.T) {
+		var moved []string
+
+		tagExistsFn = func(name string) (bool, error) { return false, nil }
+		createLightweightTagFn = func(name string) error { return nil }
+		deleteTagFn = func(name string) error { return nil }
+		forceCreateLightweightTagFn = func(name string) error {
+			moved = append(moved, name)
+			return nil
+		}
+
+		cfg := &Config{Enabled: true, AutoCreate: true, Prefix: "v", Annotate: false, FloatingTag: "latest"}
+		tm := NewTagManager(cfg)
+
+		if err := tm.CreateTag(semver.SemVersion{Major: 2, Minor: 0, Patch: 0}, ""); err != nil {
+			t.Fatalf("CreateTag() error = %v", err)
+		}
+
+		if len(moved) != 3 || moved[2] != "latest" {
+			t.Errorf("moved = %v, want [v2 v2.0 latest]", moved)
+		}
+	})
+
+	t.Run("skips aliasing for pre-release versions", func(t *testing.T) {
+		called := false
+
+		tagExistsFn = func(name string) (bool, error) { return false, nil }
+		createAnnotatedTagFn = func(name, msg string) error { return nil }
+		forceCreateLightweightTagFn = func(name string) error {
+			called = true
+			return nil
+		}
+
+		cfg := &Config{Enabled: true, AutoCreate: true, Prefix: "v", Annotate: true, FloatingTag: "latest"}
+		tm := NewTagManager(cfg)
+
+		if err := tm.CreateTag(semver.SemVersion{Major: 1, Minor: 0, Patch: 0, PreRelease: "alpha.1"}, "Release 1.0.0-alpha.1"); err != nil {
+			t.Fatalf("CreateTag() error = %v", err)
+		}
+		if called {
+			t.Error("CreateTag() should not move alias tags for a pre-release version")
+		}
+	})
+
+	t.Run("pushes the exact tag and all aliases in one call", func(t *testing.T) {
+		var pushedRefs []string
+		pushCalls := 0
+
+		tagExistsFn = func(name string) (bool, error) { return false, nil }
+		createAnnotatedTagFn = func(name, msg string) error { return nil }
+		forceCreateLightweightTagFn = func(name string) error { return nil }
+		pushRefsFn = func(remote string, atomic bool, refs []string) error {
+			pushCalls++
+			pushedRefs = refs
+			return nil
+		}
+
+		cfg := &Config{Enabled: true, AutoCreate: true, Prefix: "v", Annotate: true, Push: true}
+		tm := NewTagManager(cfg)
+
+		if err := tm.CreateTag(semver.SemVersion{Major: 1, Minor: 0, Patch: 0}, "Release 1.0.0"); err != nil {
+			t.Fatalf("CreateTag() error = %v", err)
+		}
+
+		// The exact tag plus both aliases should be pushed together, in a single call.
+		if pushCalls != 1 {
+			t.Errorf("pushRefs called %d times, want 1", pushCalls)
+		}
+		if len(pushedRefs) != 3 {
+			t.Errorf("pushedRefs = %v, want 3 entries (exact tag + 2 aliases)", pushedRefs)
+		}
+	})
+
+	t.Run("reports a combined error without aborting other aliases", func(t *testing.T) {
+		var moved []string
+
+		tagExistsFn = func(name string) (bool, error) { return false, nil }
+		createAnnotatedTagFn = func(name, msg string) error { return nil }
+		forceCreateLightweightTagFn = func(name string) error {
+			moved = append(moved, name)
+			if name == "v1" {
+				return errors.New("ref update failed")
+			}
+			return nil
+		}
+
+		cfg := &Config{Enabled: true, AutoCreate: true, Prefix: "v", Annotate: true}
+		tm := NewTagManager(cfg)
+
+		err := tm.CreateTag(semver.SemVersion{Major: 1, Minor: 0, Patch: 0}, "Release 1.0.0")
+		if err == nil {
+			t.Fatal("CreateTag() expected a combined alias error")
+		}
+		if len(moved) != 2 {
+			t.Errorf("moved = %v, want both aliases attempted despite the first failing", moved)
+		}
+	})
+}
+
 func TestTagManagerPlugin_CreateTag_PushError(t *testing.T) {
 	origTagExists := tagExistsFn
 	origCreateAnnotated := createAnnotatedTagFn
-	origPushTag := pushTagFn
+	origPushRefs := pushRefsFn
 
 	defer func() {
 		tagExistsFn = origTagExists
 		createAnnotatedTagFn = origCreateAnnotated
-		pushTagFn = origPushTag
+		pushRefsFn = origPushRefs
 	}()
 
 	tagExistsFn = func(name string) (bool, error) {
@@ -452,7 +829,7 @@ func TestTagManagerPlugin_CreateTag_PushError(t *testing.T) {
 	createAnnotatedTagFn = func(name, msg string) error {
 		return nil
 	}
-	pushTagFn = func(name string) error {
+	pushRefsFn = func(remote string, atomic bool, refs []string) error {
 		return errors.New("push failed")
 	}
 
@@ -466,6 +843,45 @@ func TestTagManagerPlugin_CreateTag_PushError(t *testing.T) {
 	}
 }
 
+func TestTagManagerPlugin_SetGitOps(t *testing.T) {
+	origTagExists := tagExistsFn
+	origCreateAnnotated := createAnnotatedTagFn
+	origDeleteTag := deleteTagFn
+
+	defer func() {
+		tagExistsFn = origTagExists
+		createAnnotatedTagFn = origCreateAnnotated
+		deleteTagFn = origDeleteTag
+	}()
+
+	tagExistsFn = func(name string) (bool, error) { return false, nil }
+	createAnnotatedTagFn = func(name, msg string) error {
+		t.Error("package-level createAnnotatedTagFn should not be called once SetGitOps is set")
+		return nil
+	}
+	deleteTagFn = func(name string) error {
+		t.Error("package-level deleteTagFn should not be called once SetGitOps is set")
+		return nil
+	}
+
+	var scopedCalls []string
+	scopedOps := createTestGitTagOps(func(name string, args ...string) *exec.Cmd {
+		scopedCalls = append(scopedCalls, strings.Join(args, " "))
+		return exec.Command("true")
+	})
+
+	cfg := &Config{Enabled: true, AutoCreate: true, Prefix: "v", Annotate: true}
+	tm := NewTagManager(cfg)
+	tm.SetGitOps(scopedOps)
+
+	if err := tm.CreateTag(semver.SemVersion{Major: 1, Minor: 0, Patch: 0}, "Release 1.0.0"); err != nil {
+		t.Fatalf("CreateTag() error = %v", err)
+	}
+	if len(scopedCalls) == 0 {
+		t.Error("CreateTag() should have run the tag creation through the injected gitOps")
+	}
+}
+
 func TestRegister(t *testing.T) {
 	// Reset before and after test
 	ResetTagManager()
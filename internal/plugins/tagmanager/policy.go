@@ -0,0 +1,54 @@
+package tagmanager
+
+import (
+	"errors"
+	"path/filepath"
+)
+
+// TagPolicy governs which tag mutations CreateTag (via ValidateTagAvailable),
+// DeleteTag, and RetagTo are permitted to perform, and what it takes for an
+// existing tag to be trusted. Install one via TagManagerPlugin.SetPolicy; a
+// nil policy (the default) disables all policy checks.
+type TagPolicy struct {
+	// Immutable rejects any attempt to delete or move an already-existing
+	// exact release tag.
+	Immutable bool
+
+	// Protected lists glob patterns (matched with filepath.Match against the
+	// tag name, e.g. "v*.*.*", "release-*"). Tags matching one of these
+	// patterns cannot be deleted or moved through DeleteTag/RetagTo. Empty
+	// means no tag is protected by name.
+	Protected []string
+
+	// RequireSignature rejects an existing tag as valid (in
+	// ValidateTagAvailable) unless "git tag -v" reports a good signature.
+	RequireSignature bool
+}
+
+var (
+	// ErrTagImmutable is returned when a policy with Immutable set blocks
+	// deleting, moving, or overwriting an existing tag.
+	ErrTagImmutable = errors.New("tag is immutable and cannot be deleted or moved")
+
+	// ErrTagProtected is returned when a policy's Protected glob list
+	// matches the tag being deleted or moved.
+	ErrTagProtected = errors.New("tag is protected and cannot be deleted or moved")
+
+	// ErrSignatureRequired is returned when a policy with RequireSignature
+	// set finds an existing tag without a valid signature.
+	ErrSignatureRequired = errors.New("tag exists but does not have a valid signature")
+)
+
+// isProtected reports whether name matches one of policy's Protected globs.
+// A nil policy or empty Protected list protects no tag by name.
+func (policy *TagPolicy) isProtected(name string) bool {
+	if policy == nil {
+		return false
+	}
+	for _, pattern := range policy.Protected {
+		if matched, err := filepath.Match(pattern, name); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
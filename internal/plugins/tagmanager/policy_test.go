@@ -0,0 +1,209 @@
+package tagmanager
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/indaco/sley/internal/semver"
+)
+
+func TestTagPolicy_IsProtected(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy *TagPolicy
+		tag    string
+		want   bool
+	}{
+		{name: "nil policy protects nothing", policy: nil, tag: "v1.2.3", want: false},
+		{name: "empty Protected list protects nothing", policy: &TagPolicy{}, tag: "v1.2.3", want: false},
+		{name: "matches version glob", policy: &TagPolicy{Protected: []string{"v*.*.*"}}, tag: "v1.2.3", want: true},
+		{name: "matches release glob", policy: &TagPolicy{Protected: []string{"release-*"}}, tag: "release-2026", want: true},
+		{name: "no match", policy: &TagPolicy{Protected: []string{"release-*"}}, tag: "v1.2.3", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.isProtected(tt.tag); got != tt.want {
+				t.Errorf("isProtected(%q) = %v, want %v", tt.tag, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTagManagerPlugin_ValidateTagAvailable_Policy(t *testing.T) {
+	origTagExists := tagExistsFn
+	origVerifyTag := verifyTagFn
+	defer func() {
+		tagExistsFn = origTagExists
+		verifyTagFn = origVerifyTag
+	}()
+
+	tagExistsFn = func(name string) (bool, error) { return true, nil }
+
+	t.Run("immutable policy returns ErrTagImmutable", func(t *testing.T) {
+		tm := NewTagManager(&Config{Prefix: "v"})
+		tm.SetPolicy(&TagPolicy{Immutable: true})
+
+		err := tm.ValidateTagAvailable(semver.SemVersion{Major: 1, Minor: 0, Patch: 0})
+		if !errors.Is(err, ErrTagImmutable) {
+			t.Errorf("ValidateTagAvailable() error = %v, want ErrTagImmutable", err)
+		}
+	})
+
+	t.Run("protected policy returns ErrTagProtected", func(t *testing.T) {
+		tm := NewTagManager(&Config{Prefix: "v"})
+		tm.SetPolicy(&TagPolicy{Protected: []string{"v*.*.*"}})
+
+		err := tm.ValidateTagAvailable(semver.SemVersion{Major: 1, Minor: 0, Patch: 0})
+		if !errors.Is(err, ErrTagProtected) {
+			t.Errorf("ValidateTagAvailable() error = %v, want ErrTagProtected", err)
+		}
+	})
+
+	t.Run("require-signature policy returns ErrSignatureRequired on bad signature", func(t *testing.T) {
+		verifyTagFn = func(name string) (VerifyResult, error) {
+			return VerifyResult{Signed: true, Good: false}, nil
+		}
+
+		tm := NewTagManager(&Config{Prefix: "v"})
+		tm.SetPolicy(&TagPolicy{RequireSignature: true})
+
+		err := tm.ValidateTagAvailable(semver.SemVersion{Major: 1, Minor: 0, Patch: 0})
+		if !errors.Is(err, ErrSignatureRequired) {
+			t.Errorf("ValidateTagAvailable() error = %v, want ErrSignatureRequired", err)
+		}
+	})
+
+	t.Run("require-signature policy passes on good signature", func(t *testing.T) {
+		verifyTagFn = func(name string) (VerifyResult, error) {
+			return VerifyResult{Signed: true, Good: true}, nil
+		}
+
+		tm := NewTagManager(&Config{Prefix: "v"})
+		tm.SetPolicy(&TagPolicy{RequireSignature: true})
+
+		err := tm.ValidateTagAvailable(semver.SemVersion{Major: 1, Minor: 0, Patch: 0})
+		if err == nil {
+			t.Error("ValidateTagAvailable() expected the generic already-exists error, got nil")
+		}
+		if errors.Is(err, ErrSignatureRequired) {
+			t.Errorf("ValidateTagAvailable() error = %v, want no ErrSignatureRequired", err)
+		}
+	})
+
+	t.Run("no policy returns the generic already-exists error", func(t *testing.T) {
+		tm := NewTagManager(&Config{Prefix: "v"})
+
+		err := tm.ValidateTagAvailable(semver.SemVersion{Major: 1, Minor: 0, Patch: 0})
+		if err == nil {
+			t.Error("ValidateTagAvailable() expected an error for an existing tag")
+		}
+		if errors.Is(err, ErrTagImmutable) || errors.Is(err, ErrTagProtected) || errors.Is(err, ErrSignatureRequired) {
+			t.Errorf("ValidateTagAvailable() error = %v, want the generic error, not a policy error", err)
+		}
+	})
+}
+
+func TestTagManagerPlugin_DeleteTag_Policy(t *testing.T) {
+	origDeleteTag := deleteTagFn
+	defer func() { deleteTagFn = origDeleteTag }()
+
+	t.Run("immutable policy blocks deletion", func(t *testing.T) {
+		deleteTagFn = func(name string) error {
+			t.Fatal("deleteTagFn should not be called when immutable")
+			return nil
+		}
+
+		tm := NewTagManager(&Config{Prefix: "v"})
+		tm.SetPolicy(&TagPolicy{Immutable: true})
+
+		err := tm.DeleteTag(semver.SemVersion{Major: 1, Minor: 0, Patch: 0})
+		if !errors.Is(err, ErrTagImmutable) {
+			t.Errorf("DeleteTag() error = %v, want ErrTagImmutable", err)
+		}
+	})
+
+	t.Run("protected glob blocks deletion of a matching tag", func(t *testing.T) {
+		deleteTagFn = func(name string) error {
+			t.Fatal("deleteTagFn should not be called for a protected tag")
+			return nil
+		}
+
+		tm := NewTagManager(&Config{Prefix: "v"})
+		tm.SetPolicy(&TagPolicy{Protected: []string{"v*.*.*"}})
+
+		err := tm.DeleteTag(semver.SemVersion{Major: 1, Minor: 0, Patch: 0})
+		if !errors.Is(err, ErrTagProtected) {
+			t.Errorf("DeleteTag() error = %v, want ErrTagProtected", err)
+		}
+	})
+
+	t.Run("no policy allows deletion", func(t *testing.T) {
+		called := false
+		deleteTagFn = func(name string) error {
+			called = true
+			return nil
+		}
+
+		tm := NewTagManager(&Config{Prefix: "v"})
+
+		if err := tm.DeleteTag(semver.SemVersion{Major: 1, Minor: 0, Patch: 0}); err != nil {
+			t.Fatalf("DeleteTag() error = %v", err)
+		}
+		if !called {
+			t.Error("DeleteTag() should have called deleteTagFn")
+		}
+	})
+}
+
+func TestTagManagerPlugin_RetagTo_Policy(t *testing.T) {
+	origTagExists := tagExistsFn
+	origDeleteTag := deleteTagFn
+	origForceCreateLightweight := forceCreateLightweightTagFn
+	defer func() {
+		tagExistsFn = origTagExists
+		deleteTagFn = origDeleteTag
+		forceCreateLightweightTagFn = origForceCreateLightweight
+	}()
+
+	t.Run("immutable policy blocks retagging", func(t *testing.T) {
+		forceCreateLightweightTagFn = func(name string) error {
+			t.Fatal("forceCreateLightweightTagFn should not be called when immutable")
+			return nil
+		}
+
+		tm := NewTagManager(&Config{Prefix: "v"})
+		tm.SetPolicy(&TagPolicy{Immutable: true})
+
+		err := tm.RetagTo(semver.SemVersion{Major: 1, Minor: 0, Patch: 0})
+		if !errors.Is(err, ErrTagImmutable) {
+			t.Errorf("RetagTo() error = %v, want ErrTagImmutable", err)
+		}
+	})
+
+	t.Run("moves an allowed tag, deleting the old ref first", func(t *testing.T) {
+		var deleted, moved bool
+
+		tagExistsFn = func(name string) (bool, error) { return true, nil }
+		deleteTagFn = func(name string) error {
+			deleted = true
+			return nil
+		}
+		forceCreateLightweightTagFn = func(name string) error {
+			moved = true
+			return nil
+		}
+
+		tm := NewTagManager(&Config{Prefix: "v"})
+
+		if err := tm.RetagTo(semver.SemVersion{Major: 1, Minor: 0, Patch: 0}); err != nil {
+			t.Fatalf("RetagTo() error = %v", err)
+		}
+		if !deleted {
+			t.Error("RetagTo() should have deleted the existing tag first")
+		}
+		if !moved {
+			t.Error("RetagTo() should have force-created the tag at its new position")
+		}
+	})
+}
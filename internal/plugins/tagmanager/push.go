@@ -0,0 +1,82 @@
+package tagmanager
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// transientPushErrorSubstrings lists lowercase fragments of git/transport
+// error messages that indicate a network-classified failure worth retrying,
+// as opposed to a deterministic one (bad credentials, non-fast-forward,
+// protected ref) that retrying can never fix.
+var transientPushErrorSubstrings = []string{
+	"could not resolve host",
+	"connection timed out",
+	"connection refused",
+	"connection reset",
+	"i/o timeout",
+	"tls handshake timeout",
+	"temporary failure in name resolution",
+	"network is unreachable",
+	"unexpected disconnect",
+	"the remote end hung up unexpectedly",
+	"early eof",
+}
+
+// IsTransientPushError reports whether err looks like a network-classified
+// push failure (DNS resolution, connection timeout/refusal, TLS handshake,
+// or a mid-transfer disconnect) that's worth retrying.
+func IsTransientPushError(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	for _, substr := range transientPushErrorSubstrings {
+		if strings.Contains(msg, substr) {
+			return true
+		}
+	}
+	return false
+}
+
+// pushRefs pushes refs to the configured remote (default "origin"), retrying
+// up to PushRetries times with exponential backoff (starting at PushBackoff,
+// default 1s) on transient failures only. When DryRun is set, it logs the
+// git command it would have run instead of executing it.
+func (p *TagManagerPlugin) pushRefs(refs []string) error {
+	remote := p.config.Remote
+	if remote == "" {
+		remote = "origin"
+	}
+
+	if p.config.DryRun {
+		pushArg := "push"
+		if p.config.PushAtomic {
+			pushArg = "push --atomic"
+		}
+		fmt.Fprintf(os.Stdout, "[dry-run] would run: git %s %s %s\n", pushArg, remote, strings.Join(refs, " "))
+		return nil
+	}
+
+	backoff := p.config.PushBackoff
+	if backoff <= 0 {
+		backoff = time.Second
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= p.config.PushRetries; attempt++ {
+		lastErr = p.gitPushRefs(remote, p.config.PushAtomic, refs)
+		if lastErr == nil {
+			return nil
+		}
+		if !IsTransientPushError(lastErr) || attempt == p.config.PushRetries {
+			return lastErr
+		}
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+
+	return lastErr
+}
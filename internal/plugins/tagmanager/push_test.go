@@ -0,0 +1,120 @@
+package tagmanager
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestIsTransientPushError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil error is not transient", err: nil, want: false},
+		{name: "could not resolve host is transient", err: errors.New("ssh: Could not resolve host: github.com"), want: true},
+		{name: "connection timed out is transient", err: errors.New("connection timed out"), want: true},
+		{name: "connection refused is transient", err: errors.New("connect: Connection refused"), want: true},
+		{name: "i/o timeout is transient", err: errors.New("read tcp: i/o timeout"), want: true},
+		{name: "early eof is transient", err: errors.New("remote end hung up: early EOF"), want: true},
+		{name: "non-fast-forward is not transient", err: errors.New("! [rejected] v1.0.0 -> v1.0.0 (non-fast-forward)"), want: false},
+		{name: "permission denied is not transient", err: errors.New("permission denied (publickey)"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsTransientPushError(tt.err); got != tt.want {
+				t.Errorf("IsTransientPushError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTagManagerPlugin_PushRefs(t *testing.T) {
+	origPushRefs := pushRefsFn
+	defer func() { pushRefsFn = origPushRefs }()
+
+	t.Run("succeeds on first attempt without sleeping", func(t *testing.T) {
+		calls := 0
+		pushRefsFn = func(remote string, atomic bool, refs []string) error {
+			calls++
+			return nil
+		}
+
+		tm := NewTagManager(&Config{Prefix: "v", Remote: "origin", PushBackoff: time.Millisecond})
+		if err := tm.pushRefs([]string{"v1.0.0"}); err != nil {
+			t.Fatalf("pushRefs() error = %v", err)
+		}
+		if calls != 1 {
+			t.Errorf("pushRefsFn called %d times, want 1", calls)
+		}
+	})
+
+	t.Run("does not retry on a non-transient error", func(t *testing.T) {
+		calls := 0
+		pushRefsFn = func(remote string, atomic bool, refs []string) error {
+			calls++
+			return errors.New("! [rejected] non-fast-forward")
+		}
+
+		tm := NewTagManager(&Config{Prefix: "v", Remote: "origin", PushRetries: 3, PushBackoff: time.Millisecond})
+		if err := tm.pushRefs([]string{"v1.0.0"}); err == nil {
+			t.Error("pushRefs() expected error")
+		}
+		if calls != 1 {
+			t.Errorf("pushRefsFn called %d times, want 1 (no retry on non-transient error)", calls)
+		}
+	})
+
+	t.Run("retries transient errors up to PushRetries then succeeds", func(t *testing.T) {
+		calls := 0
+		pushRefsFn = func(remote string, atomic bool, refs []string) error {
+			calls++
+			if calls < 3 {
+				return errors.New("connection reset by peer")
+			}
+			return nil
+		}
+
+		tm := NewTagManager(&Config{Prefix: "v", Remote: "origin", PushRetries: 3, PushBackoff: time.Millisecond})
+		if err := tm.pushRefs([]string{"v1.0.0"}); err != nil {
+			t.Fatalf("pushRefs() error = %v", err)
+		}
+		if calls != 3 {
+			t.Errorf("pushRefsFn called %d times, want 3", calls)
+		}
+	})
+
+	t.Run("gives up after exhausting PushRetries on a persistent transient error", func(t *testing.T) {
+		calls := 0
+		pushRefsFn = func(remote string, atomic bool, refs []string) error {
+			calls++
+			return errors.New("connection timed out")
+		}
+
+		tm := NewTagManager(&Config{Prefix: "v", Remote: "origin", PushRetries: 2, PushBackoff: time.Millisecond})
+		if err := tm.pushRefs([]string{"v1.0.0"}); err == nil {
+			t.Error("pushRefs() expected error")
+		}
+		if calls != 3 {
+			t.Errorf("pushRefsFn called %d times, want 3 (1 initial + 2 retries)", calls)
+		}
+	})
+
+	t.Run("dry run logs instead of pushing", func(t *testing.T) {
+		calls := 0
+		pushRefsFn = func(remote string, atomic bool, refs []string) error {
+			calls++
+			return nil
+		}
+
+		tm := NewTagManager(&Config{Prefix: "v", Remote: "origin", DryRun: true})
+		if err := tm.pushRefs([]string{"v1.0.0"}); err != nil {
+			t.Fatalf("pushRefs() error = %v", err)
+		}
+		if calls != 0 {
+			t.Errorf("pushRefsFn called %d times, want 0 in dry-run mode", calls)
+		}
+	})
+}
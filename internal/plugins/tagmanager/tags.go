@@ -0,0 +1,185 @@
+package tagmanager
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/indaco/sley/internal/semver"
+)
+
+// TagInfo describes a single git tag discovered by ListTagsWithOptions.
+type TagInfo struct {
+	// Name is the tag's short ref name (e.g. "v1.2.3").
+	Name string
+
+	// CommitSHA is the SHA the tag points at: the tagged commit's SHA for
+	// both lightweight and annotated tags.
+	CommitSHA string
+
+	// Tagger is the "Name <email>" that created an annotated/signed tag.
+	// Empty for lightweight tags.
+	Tagger string
+
+	// Date is the tag's creation date in strict ISO-8601 format. Empty for
+	// lightweight tags.
+	Date string
+
+	// Subject is the first line of an annotated tag's message. Empty for
+	// lightweight tags.
+	Subject string
+
+	// Annotation is the full body of an annotated tag's message. Empty for
+	// lightweight tags.
+	Annotation string
+}
+
+// ListTagOptions configures ListTagsWithOptions.
+type ListTagOptions struct {
+	// Pattern restricts matching tags to this glob, relative to refs/tags/
+	// (e.g. "v1.*"). Empty matches every tag.
+	Pattern string
+
+	// SemverOnly skips tag names that don't parse as a semantic version,
+	// optionally after stripping a leading non-numeric prefix (e.g. "v").
+	SemverOnly bool
+
+	// Sort selects one of git's native for-each-ref sort keys:
+	// "creatordate", "refname", or "version:refname". Prefix with "-" to
+	// reverse (e.g. "-creatordate"). Empty uses git's default order.
+	Sort string
+
+	// Limit caps the number of returned tags. 0 means no limit.
+	Limit int
+
+	// Offset skips this many matching tags before applying Limit.
+	Offset int
+
+	// MergedInto, when set, restricts results to tags reachable from this ref.
+	MergedInto string
+}
+
+// tagFieldSep and tagRecordSep delimit for-each-ref's formatted output.
+// Both are ASCII control characters unlikely to appear in tag metadata,
+// chosen so commit subjects/bodies containing commas or pipes don't corrupt
+// parsing.
+const (
+	tagFieldSep  = "\x1f"
+	tagRecordSep = "\x1e"
+)
+
+// listTagsWithOptionsFn indirects ListTagsWithOptions so callers like
+// TagManagerPlugin.GetLatestStableTag/NextPrerelease can be tested without
+// mocking execCommand directly.
+var listTagsWithOptionsFn = ListTagsWithOptions
+
+// ListTagsWithOptions lists git tags with structured metadata, filtering,
+// sorting, and pagination. Unlike ListTags, it shells out to
+// "git for-each-ref" with a stable field separator so callers (the TUI, the
+// changelog subsystem) get tagger/date/message metadata instead of bare
+// names.
+func ListTagsWithOptions(opts ListTagOptions) ([]TagInfo, error) {
+	pattern := "refs/tags/*"
+	if opts.Pattern != "" {
+		pattern = "refs/tags/" + opts.Pattern
+	}
+
+	format := strings.Join([]string{
+		"%(refname:short)",
+		"%(if)%(*objectname)%(then)%(*objectname)%(else)%(objectname)%(end)",
+		"%(taggername) %(taggeremail)",
+		"%(taggerdate:iso-strict)",
+		"%(if)%(*objectname)%(then)%(subject)%(end)",
+		"%(if)%(*objectname)%(then)%(contents:body)%(end)",
+	}, tagFieldSep) + tagRecordSep
+
+	args := []string{"for-each-ref", "--format=" + format}
+	if opts.Sort != "" {
+		args = append(args, "--sort="+opts.Sort)
+	}
+	if opts.MergedInto != "" {
+		args = append(args, "--merged="+opts.MergedInto)
+	}
+	args = append(args, pattern)
+
+	cmd := execCommand("git", args...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		stderrMsg := strings.TrimSpace(stderr.String())
+		if stderrMsg != "" {
+			return nil, fmt.Errorf("%s: %w", stderrMsg, err)
+		}
+		return nil, fmt.Errorf("git for-each-ref failed: %w", err)
+	}
+
+	tags := parseForEachRefOutput(stdout.String())
+
+	if opts.SemverOnly {
+		filtered := tags[:0]
+		for _, tag := range tags {
+			if isSemverTagName(tag.Name) {
+				filtered = append(filtered, tag)
+			}
+		}
+		tags = filtered
+	}
+
+	if opts.Offset > 0 {
+		if opts.Offset >= len(tags) {
+			return []TagInfo{}, nil
+		}
+		tags = tags[opts.Offset:]
+	}
+	if opts.Limit > 0 && opts.Limit < len(tags) {
+		tags = tags[:opts.Limit]
+	}
+
+	return tags, nil
+}
+
+// parseForEachRefOutput splits raw "git for-each-ref" output (delimited by
+// tagRecordSep/tagFieldSep) into TagInfo records.
+func parseForEachRefOutput(output string) []TagInfo {
+	var tags []TagInfo
+
+	for _, record := range strings.Split(output, tagRecordSep) {
+		record = strings.TrimPrefix(record, "\n")
+		if strings.TrimSpace(record) == "" {
+			continue
+		}
+
+		fields := strings.Split(record, tagFieldSep)
+		for len(fields) < 6 {
+			fields = append(fields, "")
+		}
+
+		tags = append(tags, TagInfo{
+			Name:       fields[0],
+			CommitSHA:  fields[1],
+			Tagger:     strings.TrimSpace(fields[2]),
+			Date:       fields[3],
+			Subject:    fields[4],
+			Annotation: strings.TrimSpace(fields[5]),
+		})
+	}
+
+	return tags
+}
+
+// isSemverTagName reports whether name parses as a semantic version,
+// optionally after stripping a leading non-numeric prefix (e.g. "v1.2.3").
+func isSemverTagName(name string) bool {
+	if _, err := semver.ParseVersion(name); err == nil {
+		return true
+	}
+	for i, r := range name {
+		if r >= '0' && r <= '9' {
+			_, err := semver.ParseVersion(name[i:])
+			return err == nil
+		}
+	}
+	return false
+}
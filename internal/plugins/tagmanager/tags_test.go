@@ -0,0 +1,207 @@
+package tagmanager
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+func TestParseForEachRefOutput(t *testing.T) {
+	record := strings.Join([]string{
+		"v1.0.0",
+		"abc123",
+		"Jane Doe <jane@example.com>",
+		"2026-01-01T00:00:00+00:00",
+		"Release 1.0.0",
+		"Full release notes.",
+	}, tagFieldSep) + tagRecordSep
+
+	tags := parseForEachRefOutput(record)
+	if len(tags) != 1 {
+		t.Fatalf("expected 1 tag, got %d", len(tags))
+	}
+
+	got := tags[0]
+	if got.Name != "v1.0.0" {
+		t.Errorf("Name = %q, want %q", got.Name, "v1.0.0")
+	}
+	if got.CommitSHA != "abc123" {
+		t.Errorf("CommitSHA = %q, want %q", got.CommitSHA, "abc123")
+	}
+	if got.Tagger != "Jane Doe <jane@example.com>" {
+		t.Errorf("Tagger = %q, want %q", got.Tagger, "Jane Doe <jane@example.com>")
+	}
+	if got.Subject != "Release 1.0.0" {
+		t.Errorf("Subject = %q, want %q", got.Subject, "Release 1.0.0")
+	}
+	if got.Annotation != "Full release notes." {
+		t.Errorf("Annotation = %q, want %q", got.Annotation, "Full release notes.")
+	}
+}
+
+func TestParseForEachRefOutput_LightweightTag(t *testing.T) {
+	record := strings.Join([]string{
+		"v1.0.0",
+		"abc123",
+		" ",
+		"",
+		"",
+		"",
+	}, tagFieldSep) + tagRecordSep
+
+	tags := parseForEachRefOutput(record)
+	if len(tags) != 1 {
+		t.Fatalf("expected 1 tag, got %d", len(tags))
+	}
+	if tags[0].Tagger != "" {
+		t.Errorf("Tagger = %q, want empty for a lightweight tag", tags[0].Tagger)
+	}
+}
+
+func TestParseForEachRefOutput_Multiple(t *testing.T) {
+	output := strings.Join([]string{
+		"v1.0.0" + tagFieldSep + "a1" + tagFieldSep + "" + tagFieldSep + "" + tagFieldSep + "" + tagFieldSep + "",
+		"v1.1.0" + tagFieldSep + "a2" + tagFieldSep + "" + tagFieldSep + "" + tagFieldSep + "" + tagFieldSep + "",
+	}, tagRecordSep+"\n") + tagRecordSep
+	output += "\n"
+
+	tags := parseForEachRefOutput(output)
+	if len(tags) != 2 {
+		t.Fatalf("expected 2 tags, got %d: %+v", len(tags), tags)
+	}
+	if tags[0].Name != "v1.0.0" || tags[1].Name != "v1.1.0" {
+		t.Errorf("unexpected tag names: %+v", tags)
+	}
+}
+
+func TestIsSemverTagName(t *testing.T) {
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"1.2.3", true},
+		{"v1.2.3", true},
+		{"v1.2.3-alpha.1", true},
+		{"release-candidate", false},
+		{"latest", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := isSemverTagName(tt.name); got != tt.want {
+			t.Errorf("isSemverTagName(%q) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestListTagsWithOptions(t *testing.T) {
+	original := execCommand
+	defer func() { execCommand = original }()
+
+	t.Run("builds for-each-ref with pattern and sort", func(t *testing.T) {
+		var gotArgs []string
+		execCommand = func(name string, args ...string) *exec.Cmd {
+			gotArgs = args
+			record := "v1.0.0" + tagFieldSep + "a1" + tagFieldSep + "" + tagFieldSep + "" + tagFieldSep + "" + tagFieldSep + "" + tagRecordSep
+			return exec.Command("printf", "%s", record)
+		}
+
+		tags, err := ListTagsWithOptions(ListTagOptions{Pattern: "v1.*", Sort: "-creatordate"})
+		if err != nil {
+			t.Fatalf("ListTagsWithOptions() error = %v", err)
+		}
+		if len(tags) != 1 || tags[0].Name != "v1.0.0" {
+			t.Errorf("tags = %+v", tags)
+		}
+
+		if gotArgs[0] != "for-each-ref" {
+			t.Errorf("expected for-each-ref, got %v", gotArgs)
+		}
+		foundSort := false
+		foundPattern := false
+		for _, a := range gotArgs {
+			if a == "--sort=-creatordate" {
+				foundSort = true
+			}
+			if a == "refs/tags/v1.*" {
+				foundPattern = true
+			}
+		}
+		if !foundSort {
+			t.Errorf("expected --sort=-creatordate in args: %v", gotArgs)
+		}
+		if !foundPattern {
+			t.Errorf("expected refs/tags/v1.* in args: %v", gotArgs)
+		}
+	})
+
+	t.Run("filters non-semver names", func(t *testing.T) {
+		execCommand = func(name string, args ...string) *exec.Cmd {
+			records := []string{
+				"v1.0.0" + tagFieldSep + "a1" + tagFieldSep + "" + tagFieldSep + "" + tagFieldSep + "" + tagFieldSep + "",
+				"latest" + tagFieldSep + "a2" + tagFieldSep + "" + tagFieldSep + "" + tagFieldSep + "" + tagFieldSep + "",
+			}
+			return exec.Command("printf", "%s", strings.Join(records, tagRecordSep+"\n")+tagRecordSep)
+		}
+
+		tags, err := ListTagsWithOptions(ListTagOptions{SemverOnly: true})
+		if err != nil {
+			t.Fatalf("ListTagsWithOptions() error = %v", err)
+		}
+		if len(tags) != 1 || tags[0].Name != "v1.0.0" {
+			t.Errorf("tags = %+v, want only v1.0.0", tags)
+		}
+	})
+
+	t.Run("applies offset and limit", func(t *testing.T) {
+		execCommand = func(name string, args ...string) *exec.Cmd {
+			records := []string{
+				"v1.0.0" + tagFieldSep + "a1" + tagFieldSep + "" + tagFieldSep + "" + tagFieldSep + "" + tagFieldSep + "",
+				"v1.1.0" + tagFieldSep + "a2" + tagFieldSep + "" + tagFieldSep + "" + tagFieldSep + "" + tagFieldSep + "",
+				"v1.2.0" + tagFieldSep + "a3" + tagFieldSep + "" + tagFieldSep + "" + tagFieldSep + "" + tagFieldSep + "",
+			}
+			return exec.Command("printf", "%s", strings.Join(records, tagRecordSep+"\n")+tagRecordSep)
+		}
+
+		tags, err := ListTagsWithOptions(ListTagOptions{Offset: 1, Limit: 1})
+		if err != nil {
+			t.Fatalf("ListTagsWithOptions() error = %v", err)
+		}
+		if len(tags) != 1 || tags[0].Name != "v1.1.0" {
+			t.Errorf("tags = %+v, want only v1.1.0", tags)
+		}
+	})
+
+	t.Run("merged-into adds --merged flag", func(t *testing.T) {
+		var gotArgs []string
+		execCommand = func(name string, args ...string) *exec.Cmd {
+			gotArgs = args
+			return exec.Command("true")
+		}
+
+		if _, err := ListTagsWithOptions(ListTagOptions{MergedInto: "main"}); err != nil {
+			t.Fatalf("ListTagsWithOptions() error = %v", err)
+		}
+
+		found := false
+		for _, a := range gotArgs {
+			if a == "--merged=main" {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("expected --merged=main in args: %v", gotArgs)
+		}
+	})
+
+	t.Run("error with stderr", func(t *testing.T) {
+		execCommand = func(name string, args ...string) *exec.Cmd {
+			return exec.Command("sh", "-c", "echo 'bad ref' >&2 && exit 1")
+		}
+
+		_, err := ListTagsWithOptions(ListTagOptions{})
+		if err == nil {
+			t.Error("ListTagsWithOptions() expected error")
+		}
+	})
+}
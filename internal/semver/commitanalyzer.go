@@ -0,0 +1,128 @@
+package semver
+
+import (
+	"regexp"
+	"strings"
+)
+
+// conventionalCommitSubjectRe matches a Conventional Commits subject line:
+// type(scope)!: description.
+var conventionalCommitSubjectRe = regexp.MustCompile(
+	`^(?P<type>\w+)(\((?P<scope>[^)]+)\))?(?P<breaking>!)?:\s*(?P<desc>.+)$`,
+)
+
+// breakingFooterRe matches a "BREAKING CHANGE:" or "BREAKING-CHANGE:" footer
+// anywhere in a commit body.
+var breakingFooterRe = regexp.MustCompile(`(?im)^BREAKING[- ]CHANGE:`)
+
+// ParsedCommit is a single Conventional Commit parsed from a raw commit
+// message (subject plus body/footers).
+type ParsedCommit struct {
+	Type        string
+	Scope       string
+	Breaking    bool
+	Description string
+	Raw         string
+}
+
+// CommitTypeMapping maps a Conventional Commits type (e.g. "feat") to the
+// bump kind it should trigger ("major", "minor", or "patch").
+type CommitTypeMapping map[string]string
+
+// DefaultCommitTypeMapping returns the built-in commit-types mapping, used
+// when a CommitAnalyzer is created without an explicit one (e.g. no
+// "commit-types" key in .sley.yaml).
+func DefaultCommitTypeMapping() CommitTypeMapping {
+	return CommitTypeMapping{
+		"feat": "minor",
+		"fix":  "patch",
+		"perf": "patch",
+	}
+}
+
+// bumpRank orders bump kinds so CommitAnalyzer can pick the most significant
+// one seen across a commit range.
+var bumpRank = map[string]int{
+	"":      0,
+	"patch": 1,
+	"minor": 2,
+	"major": 3,
+}
+
+// CommitAnalyzer derives a bump kind from a range of Conventional Commits,
+// per a configurable CommitTypeMapping. It is the engine behind
+// operations.BumpOperation's "auto" mode when git history is available.
+type CommitAnalyzer struct {
+	mapping CommitTypeMapping
+}
+
+// NewCommitAnalyzer creates a CommitAnalyzer using mapping to resolve commit
+// types to bump kinds. A nil mapping falls back to DefaultCommitTypeMapping.
+func NewCommitAnalyzer(mapping CommitTypeMapping) *CommitAnalyzer {
+	if mapping == nil {
+		mapping = DefaultCommitTypeMapping()
+	}
+	return &CommitAnalyzer{mapping: mapping}
+}
+
+// Analyze parses each raw commit message in messages and returns the
+// aggregated bump kind ("major", "minor", "patch", or "" if no commit maps
+// to a bump kind) alongside the successfully parsed commits, for downstream
+// changelog generation.
+func (a *CommitAnalyzer) Analyze(messages []string) (bumpKind string, commits []ParsedCommit) {
+	for _, msg := range messages {
+		pc, ok := a.parse(msg)
+		if !ok {
+			continue
+		}
+		commits = append(commits, pc)
+
+		kind := a.bumpKindFor(pc)
+		if bumpRank[kind] > bumpRank[bumpKind] {
+			bumpKind = kind
+		}
+	}
+	return bumpKind, commits
+}
+
+// bumpKindFor resolves a single parsed commit to a bump kind: breaking
+// changes always win major, otherwise the mapping is consulted.
+func (a *CommitAnalyzer) bumpKindFor(pc ParsedCommit) string {
+	if pc.Breaking {
+		return "major"
+	}
+	return a.mapping[pc.Type]
+}
+
+// parse splits msg into subject and body, matches the subject against the
+// Conventional Commits pattern, and checks the body for a BREAKING CHANGE
+// footer. It returns ok=false for messages that aren't Conventional Commits.
+func (a *CommitAnalyzer) parse(msg string) (pc ParsedCommit, ok bool) {
+	subject, body, _ := strings.Cut(msg, "\n")
+
+	m := conventionalCommitSubjectRe.FindStringSubmatch(subject)
+	if m == nil {
+		return ParsedCommit{}, false
+	}
+
+	get := func(name string) string {
+		idx := conventionalCommitSubjectRe.SubexpIndex(name)
+		if idx < 0 || idx >= len(m) {
+			return ""
+		}
+		return m[idx]
+	}
+
+	pc = ParsedCommit{
+		Type:        get("type"),
+		Scope:       get("scope"),
+		Breaking:    get("breaking") == "!",
+		Description: get("desc"),
+		Raw:         msg,
+	}
+	if breakingFooterRe.MatchString(body) {
+		pc.Breaking = true
+	}
+
+	return pc, true
+}
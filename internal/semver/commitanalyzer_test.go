@@ -0,0 +1,93 @@
+package semver
+
+import "testing"
+
+func TestCommitAnalyzer_Analyze_PicksHighestBumpKind(t *testing.T) {
+	tests := []struct {
+		name     string
+		messages []string
+		want     string
+	}{
+		{
+			name:     "no relevant commits is a no-op",
+			messages: []string{"chore: update deps", "docs: fix typo"},
+			want:     "",
+		},
+		{
+			name:     "fix only yields patch",
+			messages: []string{"fix: resolve crash", "chore: cleanup"},
+			want:     "patch",
+		},
+		{
+			name:     "feat outranks fix",
+			messages: []string{"fix: resolve crash", "feat: add export"},
+			want:     "minor",
+		},
+		{
+			name:     "breaking exclamation outranks feat",
+			messages: []string{"feat: add export", "feat!: remove old API"},
+			want:     "major",
+		},
+		{
+			name:     "breaking footer outranks feat",
+			messages: []string{"feat: add export", "chore: cleanup\n\nBREAKING CHANGE: removed flag"},
+			want:     "major",
+		},
+		{
+			name:     "scoped commit still parses",
+			messages: []string{"feat(api): add endpoint"},
+			want:     "minor",
+		},
+		{
+			name:     "non-conventional commit is ignored",
+			messages: []string{"random merge commit message"},
+			want:     "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analyzer := NewCommitAnalyzer(nil)
+			got, _ := analyzer.Analyze(tt.messages)
+			if got != tt.want {
+				t.Errorf("Analyze() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCommitAnalyzer_Analyze_ReturnsParsedCommits(t *testing.T) {
+	analyzer := NewCommitAnalyzer(nil)
+	_, commits := analyzer.Analyze([]string{"feat(api): add endpoint", "not conventional"})
+
+	if len(commits) != 1 {
+		t.Fatalf("expected 1 parsed commit, got %d", len(commits))
+	}
+	if commits[0].Type != "feat" || commits[0].Scope != "api" || commits[0].Description != "add endpoint" {
+		t.Errorf("unexpected parsed commit: %+v", commits[0])
+	}
+}
+
+func TestCommitAnalyzer_Analyze_CustomMapping(t *testing.T) {
+	analyzer := NewCommitAnalyzer(CommitTypeMapping{"docs": "patch"})
+	got, _ := analyzer.Analyze([]string{"docs: update README"})
+	if got != "patch" {
+		t.Errorf("expected custom mapping to classify 'docs' as patch, got %q", got)
+	}
+
+	// feat is not in the custom mapping, so it should be a no-op.
+	got, _ = analyzer.Analyze([]string{"feat: add feature"})
+	if got != "" {
+		t.Errorf("expected 'feat' outside custom mapping to be a no-op, got %q", got)
+	}
+}
+
+func TestDefaultCommitTypeMapping(t *testing.T) {
+	mapping := DefaultCommitTypeMapping()
+	want := map[string]string{"feat": "minor", "fix": "patch", "perf": "patch"}
+	for k, v := range want {
+		if mapping[k] != v {
+			t.Errorf("expected mapping[%q] = %q, got %q", k, v, mapping[k])
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package semver
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+)
+
+// GitCommitLogReader abstracts reading the commit messages between the
+// repository's last tag and HEAD, so CommitAnalyzer-driven auto bumps can be
+// tested without invoking git.
+type GitCommitLogReader interface {
+	// CommitsSinceLastTag returns the full raw message (subject + body) of
+	// every commit in the range since..until. An empty since defaults to the
+	// repository's most recent tag, or the full history if the repository
+	// has no tags yet. An empty until defaults to HEAD.
+	CommitsSinceLastTag(ctx context.Context, since, until string) ([]string, error)
+}
+
+// NewGitCommitLogReader returns a GitCommitLogReader backed by the system
+// git binary.
+func NewGitCommitLogReader() GitCommitLogReader {
+	return &realGitCommitLogReader{}
+}
+
+// realGitCommitLogReader implements GitCommitLogReader using actual git commands.
+type realGitCommitLogReader struct{}
+
+func (g *realGitCommitLogReader) CommitsSinceLastTag(ctx context.Context, since, until string) ([]string, error) {
+	if until == "" {
+		until = "HEAD"
+	}
+	if since == "" {
+		since = defaultSince(ctx)
+	}
+
+	rangeSpec := until
+	if since != "" {
+		rangeSpec = since + ".." + until
+	}
+
+	// %x00 separates full commit messages (%B) so multi-line bodies don't
+	// get confused with the next commit's subject.
+	output, err := exec.CommandContext(ctx, "git", "log", rangeSpec, "--format=%B%x00").Output()
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []string
+	for _, raw := range strings.Split(string(output), "\x00") {
+		if msg := strings.TrimSpace(raw); msg != "" {
+			messages = append(messages, msg)
+		}
+	}
+	return messages, nil
+}
+
+// defaultSince resolves the repository's most recent tag. It returns "" when
+// the repository has no tags yet, so the caller reads the full history.
+func defaultSince(ctx context.Context) string {
+	if tag, err := exec.CommandContext(ctx, "git", "describe", "--tags", "--abbrev=0").Output(); err == nil {
+		if trimmed := strings.TrimSpace(string(tag)); trimmed != "" {
+			return trimmed
+		}
+	}
+	return ""
+}
+
+// MockGitCommitLogReader is a test helper for mocking commit history reads.
+type MockGitCommitLogReader struct {
+	Commits []string
+	Err     error
+}
+
+func (m *MockGitCommitLogReader) CommitsSinceLastTag(ctx context.Context, since, until string) ([]string, error) {
+	return m.Commits, m.Err
+}